@@ -72,7 +72,6 @@ import (
 	"github.com/Azure/go-autorest/autorest/adal"
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
-	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -89,6 +88,27 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              az.Environment
 	skipProviderRegistration bool
+	retryPolicy              azure.RetryPolicy
+
+	// defaultTags are the tags defined in the `default_tags` block of the Provider, which are
+	// applied to every resource which supports tags
+	defaultTags map[string]string
+
+	// ignoredTags are the tags defined in the `ignore_tag_keys` and `ignore_tag_prefixes` fields of the
+	// Provider, which are managed outside of Terraform and as such are neither reported nor removed
+	ignoredTags azure.IgnoredTags
+
+	// existingIgnoredTags are the ignored tags assigned to the resource being updated, see `preserveIgnoredTagsForResource`
+	existingIgnoredTags map[string]*string
+
+	// validateResourceSkus specifies whether the availability of SKUs should be validated during the plan
+	validateResourceSkus bool
+
+	// resourceSkus caches the Resource SKUs available to the Subscription, see `listResourceSkus`
+	resourceSkus *resourceSkusCache
+
+	// clientBuilder is used to build the clients for other Subscriptions, see `forSubscription`
+	clientBuilder *armClientBuilder
 
 	StopContext context.Context
 
@@ -173,19 +193,23 @@ type ArmClient struct {
 	cognitiveAccountsClient cognitiveservices.AccountsClient
 
 	// Compute
-	availSetClient             compute.AvailabilitySetsClient
-	diskClient                 compute.DisksClient
-	imageClient                compute.ImagesClient
-	galleriesClient            compute.GalleriesClient
-	galleryImagesClient        compute.GalleryImagesClient
-	galleryImageVersionsClient compute.GalleryImageVersionsClient
-	snapshotsClient            compute.SnapshotsClient
-	usageOpsClient             compute.UsageClient
-	vmExtensionImageClient     compute.VirtualMachineExtensionImagesClient
-	vmExtensionClient          compute.VirtualMachineExtensionsClient
-	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
-	vmImageClient              compute.VirtualMachineImagesClient
-	vmClient                   compute.VirtualMachinesClient
+	availSetClient                  compute.AvailabilitySetsClient
+	diskClient                      compute.DisksClient
+	imageClient                     compute.ImagesClient
+	resourceSkusClient              compute.ResourceSkusClient
+	galleriesClient                 compute.GalleriesClient
+	galleryImagesClient             compute.GalleryImagesClient
+	galleryImageVersionsClient      compute.GalleryImageVersionsClient
+	snapshotsClient                 compute.SnapshotsClient
+	usageOpsClient                  compute.UsageClient
+	vmExtensionImageClient          compute.VirtualMachineExtensionImagesClient
+	vmExtensionClient               compute.VirtualMachineExtensionsClient
+	vmScaleSetClient                compute.VirtualMachineScaleSetsClient
+	vmScaleSetExtensionsClient      compute.VirtualMachineScaleSetExtensionsClient
+	vmScaleSetVMsClient             compute.VirtualMachineScaleSetVMsClient
+	vmScaleSetRollingUpgradesClient compute.VirtualMachineScaleSetRollingUpgradesClient
+	vmImageClient                   compute.VirtualMachineImagesClient
+	vmClient                        compute.VirtualMachinesClient
 
 	// Devices
 	iothubResourceClient devices.IotHubResourceClient
@@ -268,6 +292,7 @@ type ArmClient struct {
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
 	azureFirewallsClient            network.AzureFirewallsClient
+	bgpServiceCommunitiesClient     network.BgpServiceCommunitiesClient
 	connectionMonitorsClient        network.ConnectionMonitorsClient
 	ddosProtectionPlanClient        network.DdosProtectionPlansClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
@@ -277,7 +302,10 @@ type ArmClient struct {
 	loadBalancerClient              network.LoadBalancersClient
 	localNetConnClient              network.LocalNetworkGatewaysClient
 	packetCapturesClient            network.PacketCapturesClient
+	privateEndpointClient           network.InterfaceEndpointsClient
 	publicIPClient                  network.PublicIPAddressesClient
+	publicIPPrefixClient            network.PublicIPPrefixesClient
+	routeFilterClient               network.RouteFiltersClient
 	routesClient                    network.RoutesClient
 	routeTablesClient               network.RouteTablesClient
 	secGroupClient                  network.SecurityGroupsClient
@@ -287,6 +315,12 @@ type ArmClient struct {
 	vnetGatewayClient               network.VirtualNetworkGatewaysClient
 	vnetClient                      network.VirtualNetworksClient
 	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
+	virtualHubClient                network.VirtualHubsClient
+	virtualHubConnectionClient      network.HubVirtualNetworkConnectionsClient
+	virtualWanClient                network.VirtualWansClient
+	vpnGatewayClient                network.VpnGatewaysClient
+	vpnGatewayConnectionClient      network.VpnConnectionsClient
+	vpnSiteClient                   network.VpnSitesClient
 	watcherClient                   network.WatchersClient
 
 	// Notification Hubs
@@ -355,33 +389,78 @@ type ArmClient struct {
 	policySetDefinitionsClient policy.SetDefinitionsClient
 }
 
-var (
-	msClientRequestIDOnce sync.Once
-	msClientRequestID     string
-)
+// withRequestTracker returns a copy of the client for a single operation, whose StopContext
+// tracks the ID's of the requests sent to Azure during that operation
+func (c *ArmClient) withRequestTracker() (*ArmClient, *azure.RequestTracker) {
+	ctx, tracker := azure.WithRequestTracker(c.StopContext)
 
-// clientRequestID generates a UUID to pass through `x-ms-client-request-id` header.
-func clientRequestID() string {
-	msClientRequestIDOnce.Do(func() {
-		var err error
-		msClientRequestID, err = uuid.GenerateUUID()
+	client := *c
+	client.StopContext = ctx
+	return &client, tracker
+}
 
-		if err != nil {
-			log.Printf("[WARN] Fail to generate uuid for msClientRequestID: %+v", err)
+// armClientBuilder contains the Authorizers used to build the clients for each Subscription,
+// so that these can be created on demand using the credentials the Provider was configured with
+type armClientBuilder struct {
+	endpoint      string
+	graphEndpoint string
+	auth          autorest.Authorizer
+	graphAuth     autorest.Authorizer
+	keyVaultAuth  autorest.Authorizer
+	sender        autorest.Sender
+
+	lock    sync.Mutex
+	clients map[string]*ArmClient
+}
+
+// forSubscription returns a copy of the client whose SDK clients target the specified Subscription.
+// The clients for each Subscription are created the first time they're needed and then cached.
+func (c *ArmClient) forSubscription(subscriptionId string) *ArmClient {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, c.subscriptionId) || c.clientBuilder == nil {
+		return c
+	}
+
+	b := c.clientBuilder
+	b.lock.Lock()
+	key := strings.ToLower(subscriptionId)
+	subscriptionClient, ok := b.clients[key]
+	if !ok {
+		log.Printf("[DEBUG] Building the clients for Subscription %q", subscriptionId)
+		subscriptionClient = &ArmClient{
+			clientId:                 c.clientId,
+			tenantId:                 c.tenantId,
+			subscriptionId:           subscriptionId,
+			partnerId:                c.partnerId,
+			usingServicePrincipal:    c.usingServicePrincipal,
+			environment:              c.environment,
+			skipProviderRegistration: c.skipProviderRegistration,
+			retryPolicy:              c.retryPolicy,
+			defaultTags:              c.defaultTags,
+			ignoredTags:              c.ignoredTags,
+			clientBuilder:            b,
 		}
-	})
+		subscriptionClient.registerClients(subscriptionId)
+		b.clients[key] = subscriptionClient
+	}
+	b.lock.Unlock()
 
-	log.Printf("[DEBUG] AzureRM Client Request Id: %s", msClientRequestID)
-	return msClientRequestID
+	// the StopContext is specific to the current operation
+	client := *subscriptionClient
+	client.StopContext = c.StopContext
+	return &client
 }
 
 func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
 	setUserAgent(client, c.partnerId)
 	client.Authorizer = auth
-	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = azure.BuildSender()
+	client.Sender = azure.BuildSender(c.retryPolicy)
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
 	client.PollingDuration = 60 * time.Minute
+
+	if azure.CurrentRecordingMode() == azure.RecordingModeReplay {
+		// there's no need to wait between polling recorded responses
+		client.PollingDelay = 0
+	}
 }
 
 func setUserAgent(client *autorest.Client, partnerID string) {
@@ -404,9 +483,18 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
 }
 
+// armClientAuthOptions configures the authentication options which aren't supported by the `authentication` package
+type armClientAuthOptions struct {
+	// clientAssertion is used to obtain tokens rather than the authentication method within the Config, when set
+	clientAssertion *azure.ClientAssertionAuth
+
+	// auxiliaryTenantIds are the Tenants which tokens are sent for, in addition to the primary Tenant
+	auxiliaryTenantIds []string
+}
+
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string) (*ArmClient, error) {
+func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string, retryPolicy azure.RetryPolicy, authOptions armClientAuthOptions) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(c.Environment)
 	if err != nil {
 		return nil, err
@@ -421,6 +509,7 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		environment:              *env,
 		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
 		skipProviderRegistration: skipProviderRegistration,
+		retryPolicy:              retryPolicy,
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -433,78 +522,126 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
+	getAuthorizationToken := c.GetAuthorizationToken
+	if authOptions.clientAssertion != nil {
+		getAuthorizationToken = authOptions.clientAssertion.GetAuthorizationToken
+	}
+
+	// NOTE: when replaying recorded requests nothing is sent to Azure, so the credentials aren't used
+
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := c.GetAuthorizationToken(oauthConfig, env.TokenAudience)
+	armToken, err := getAuthorizationToken(oauthConfig, env.TokenAudience)
 	if err != nil {
 		return nil, err
 	}
 
+	// the Azure CLI and Managed Service Identity authentication methods ignore the Tenant in the OAuthConfig,
+	// so these would silently obtain a token for the primary Tenant rather than each Auxiliary Tenant
+	if len(authOptions.auxiliaryTenantIds) > 0 && !c.AuthenticatedAsAServicePrincipal && authOptions.clientAssertion == nil {
+		return nil, fmt.Errorf("`auxiliary_tenant_ids` can only be used when authenticating as a Service Principal using a Client Secret, Client Certificate or OIDC Token")
+	}
+
+	auxiliaryTokens := make([]autorest.Authorizer, 0)
+	for _, tenantId := range authOptions.auxiliaryTenantIds {
+		auxiliaryOAuthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantId)
+		if err != nil {
+			return nil, err
+		}
+		if auxiliaryOAuthConfig == nil {
+			return nil, fmt.Errorf("Unable to configure OAuthConfig for Auxiliary Tenant %s", tenantId)
+		}
+
+		auxiliaryToken, err := getAuthorizationToken(auxiliaryOAuthConfig, env.TokenAudience)
+		if err != nil {
+			return nil, fmt.Errorf("Error obtaining a token for Auxiliary Tenant %s: %+v", tenantId, err)
+		}
+		auxiliaryTokens = append(auxiliaryTokens, auxiliaryToken)
+	}
+	auth := azure.AuthorizerForRecordingMode(azure.AuxiliaryTenantsAuthorizer(armToken, auxiliaryTokens))
+
 	// Graph Endpoints
 	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := c.GetAuthorizationToken(oauthConfig, graphEndpoint)
+	graphToken, err := getAuthorizationToken(oauthConfig, graphEndpoint)
 	if err != nil {
 		return nil, err
 	}
+	graphAuth := azure.AuthorizerForRecordingMode(graphToken)
 
 	// Key Vault Endpoints
-	sender := azure.BuildSender()
-	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
-		keyVaultSpt, err := c.GetAuthorizationToken(oauthConfig, resource)
+	sender := azure.BuildSender(retryPolicy)
+	keyVaultAuth := azure.AuthorizerForRecordingMode(autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+		keyVaultSpt, err := getAuthorizationToken(oauthConfig, resource)
 		if err != nil {
 			return nil, err
 		}
 
 		return keyVaultSpt, nil
-	})
-
-	client.registerApiManagementServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerAutomationClients(endpoint, c.SubscriptionID, auth)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth)
-	client.registerBatchClients(endpoint, c.SubscriptionID, auth)
-	client.registerCDNClients(endpoint, c.SubscriptionID, auth)
-	client.registerCognitiveServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerInstanceClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerRegistryClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
-	client.registerCosmosDBClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabricksClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth)
-	client.registerDeviceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevSpaceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventGridClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventHubClients(endpoint, c.SubscriptionID, auth)
-	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth)
-	client.registerLogicClients(endpoint, c.SubscriptionID, auth)
-	client.registerMediaServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth)
-	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth)
-	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
-	client.registerManagementGroupClients(endpoint, auth)
-	client.registerRedisClients(endpoint, c.SubscriptionID, auth)
-	client.registerRelayClients(endpoint, c.SubscriptionID, auth)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
-	client.registerSecurityCenterClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceFabricClients(endpoint, c.SubscriptionID, auth)
-	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
-	client.registerSignalRClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
-	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
-	client.registerWebClients(endpoint, c.SubscriptionID, auth)
+	}))
+
+	client.clientBuilder = &armClientBuilder{
+		endpoint:      endpoint,
+		graphEndpoint: graphEndpoint,
+		auth:          auth,
+		graphAuth:     graphAuth,
+		keyVaultAuth:  keyVaultAuth,
+		sender:        sender,
+		clients:       make(map[string]*ArmClient),
+	}
+	client.registerClients(c.SubscriptionID)
 
 	return &client, nil
 }
 
+// registerClients configures all of the SDK clients to use the specified Subscription
+func (c *ArmClient) registerClients(subscriptionId string) {
+	b := c.clientBuilder
+
+	c.registerApiManagementServiceClients(b.endpoint, subscriptionId, b.auth)
+	c.registerAppInsightsClients(b.endpoint, subscriptionId, b.auth)
+	c.registerAutomationClients(b.endpoint, subscriptionId, b.auth)
+	c.registerAuthentication(b.endpoint, b.graphEndpoint, subscriptionId, c.tenantId, b.auth, b.graphAuth)
+	c.registerBatchClients(b.endpoint, subscriptionId, b.auth)
+	c.registerCDNClients(b.endpoint, subscriptionId, b.auth)
+	c.registerCognitiveServiceClients(b.endpoint, subscriptionId, b.auth)
+	c.registerComputeClients(b.endpoint, subscriptionId, b.auth)
+	c.registerContainerInstanceClients(b.endpoint, subscriptionId, b.auth)
+	c.registerContainerRegistryClients(b.endpoint, subscriptionId, b.auth)
+	c.registerContainerServicesClients(b.endpoint, subscriptionId, b.auth)
+	c.registerCosmosDBClients(b.endpoint, subscriptionId, b.auth)
+	c.registerDatabricksClients(b.endpoint, subscriptionId, b.auth)
+	c.registerDatabases(b.endpoint, subscriptionId, b.auth, b.sender)
+	c.registerDataLakeStoreClients(b.endpoint, subscriptionId, b.auth)
+	c.registerDeviceClients(b.endpoint, subscriptionId, b.auth)
+	c.registerDevSpaceClients(b.endpoint, subscriptionId, b.auth)
+	c.registerDevTestClients(b.endpoint, subscriptionId, b.auth)
+	c.registerDNSClients(b.endpoint, subscriptionId, b.auth)
+	c.registerEventGridClients(b.endpoint, subscriptionId, b.auth)
+	c.registerEventHubClients(b.endpoint, subscriptionId, b.auth)
+	c.registerKeyVaultClients(b.endpoint, subscriptionId, b.auth, b.keyVaultAuth)
+	c.registerLogicClients(b.endpoint, subscriptionId, b.auth)
+	c.registerMediaServiceClients(b.endpoint, subscriptionId, b.auth)
+	c.registerMonitorClients(b.endpoint, subscriptionId, b.auth)
+	c.registerNetworkingClients(b.endpoint, subscriptionId, b.auth)
+	c.registerNotificationHubsClient(b.endpoint, subscriptionId, b.auth)
+	c.registerOperationalInsightsClients(b.endpoint, subscriptionId, b.auth)
+	c.registerRecoveryServiceClients(b.endpoint, subscriptionId, b.auth)
+	c.registerPolicyClients(b.endpoint, subscriptionId, b.auth)
+	c.registerManagementGroupClients(b.endpoint, b.auth)
+	c.registerRedisClients(b.endpoint, subscriptionId, b.auth)
+	c.registerRelayClients(b.endpoint, subscriptionId, b.auth)
+	c.registerResourcesClients(b.endpoint, subscriptionId, b.auth)
+	c.registerSearchClients(b.endpoint, subscriptionId, b.auth)
+	c.registerSecurityCenterClients(b.endpoint, subscriptionId, b.auth)
+	c.registerServiceBusClients(b.endpoint, subscriptionId, b.auth)
+	c.registerServiceFabricClients(b.endpoint, subscriptionId, b.auth)
+	c.registerSchedulerClients(b.endpoint, subscriptionId, b.auth)
+	c.registerSignalRClients(b.endpoint, subscriptionId, b.auth)
+	c.registerStorageClients(b.endpoint, subscriptionId, b.auth)
+	c.registerTrafficManagerClients(b.endpoint, subscriptionId, b.auth)
+	c.registerWebClients(b.endpoint, subscriptionId, b.auth)
+}
+
 func (c *ArmClient) registerApiManagementServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	apisClient := apimanagement.NewAPIClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&apisClient.Client, auth)
@@ -708,6 +845,11 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&snapshotsClient.Client, auth)
 	c.snapshotsClient = snapshotsClient
 
+	resourceSkusClient := compute.NewResourceSkusClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&resourceSkusClient.Client, auth)
+	c.resourceSkusClient = resourceSkusClient
+	c.resourceSkus = &resourceSkusCache{}
+
 	usageClient := compute.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
 	c.usageOpsClient = usageClient
@@ -728,6 +870,18 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&scaleSetsClient.Client, auth)
 	c.vmScaleSetClient = scaleSetsClient
 
+	scaleSetExtensionsClient := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetExtensionsClient.Client, auth)
+	c.vmScaleSetExtensionsClient = scaleSetExtensionsClient
+
+	scaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetVMsClient.Client, auth)
+	c.vmScaleSetVMsClient = scaleSetVMsClient
+
+	scaleSetRollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetRollingUpgradesClient.Client, auth)
+	c.vmScaleSetRollingUpgradesClient = scaleSetRollingUpgradesClient
+
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
 	c.vmClient = virtualMachinesClient
@@ -1021,6 +1175,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&azureFirewallsClient.Client, auth)
 	c.azureFirewallsClient = azureFirewallsClient
 
+	bgpServiceCommunitiesClient := network.NewBgpServiceCommunitiesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&bgpServiceCommunitiesClient.Client, auth)
+	c.bgpServiceCommunitiesClient = bgpServiceCommunitiesClient
+
 	connectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&connectionMonitorsClient.Client, auth)
 	c.connectionMonitorsClient = connectionMonitorsClient
@@ -1069,6 +1227,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&packetCapturesClient.Client, auth)
 	c.packetCapturesClient = packetCapturesClient
 
+	privateEndpointsClient := network.NewInterfaceEndpointsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&privateEndpointsClient.Client, auth)
+	c.privateEndpointClient = privateEndpointsClient
+
 	peeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&peeringsClient.Client, auth)
 	c.vnetPeeringsClient = peeringsClient
@@ -1077,6 +1239,14 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&publicIPAddressesClient.Client, auth)
 	c.publicIPClient = publicIPAddressesClient
 
+	publicIPPrefixesClient := network.NewPublicIPPrefixesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&publicIPPrefixesClient.Client, auth)
+	c.publicIPPrefixClient = publicIPPrefixesClient
+
+	routeFiltersClient := network.NewRouteFiltersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&routeFiltersClient.Client, auth)
+	c.routeFilterClient = routeFiltersClient
+
 	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routesClient.Client, auth)
 	c.routesClient = routesClient
@@ -1101,6 +1271,30 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&userAssignedIdentitiesClient.Client, auth)
 	c.userAssignedIdentitiesClient = userAssignedIdentitiesClient
 
+	virtualHubsClient := network.NewVirtualHubsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualHubsClient.Client, auth)
+	c.virtualHubClient = virtualHubsClient
+
+	virtualHubConnectionsClient := network.NewHubVirtualNetworkConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualHubConnectionsClient.Client, auth)
+	c.virtualHubConnectionClient = virtualHubConnectionsClient
+
+	virtualWansClient := network.NewVirtualWansClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualWansClient.Client, auth)
+	c.virtualWanClient = virtualWansClient
+
+	vpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnGatewaysClient.Client, auth)
+	c.vpnGatewayClient = vpnGatewaysClient
+
+	vpnConnectionsClient := network.NewVpnConnectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnConnectionsClient.Client, auth)
+	c.vpnGatewayConnectionClient = vpnConnectionsClient
+
+	vpnSitesClient := network.NewVpnSitesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnSitesClient.Client, auth)
+	c.vpnSiteClient = vpnSitesClient
+
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
 	c.watcherClient = watchersClient
@@ -1444,3 +1638,35 @@ func (c *ArmClient) getQueueServiceClientForStorageAccount(ctx context.Context,
 	queueClient := storageClient.GetQueueService()
 	return &queueClient, true, nil
 }
+
+// resourceSkusCache holds the Compute Resource SKUs available to a Subscription, once these have been retrieved
+type resourceSkusCache struct {
+	lock sync.Mutex
+	skus []compute.ResourceSku
+}
+
+// listResourceSkus returns the Compute Resource SKUs available to the Subscription, which are retrieved once
+// and then cached since this list is large and doesn't change during a Terraform run
+func (c *ArmClient) listResourceSkus(ctx context.Context) ([]compute.ResourceSku, error) {
+	c.resourceSkus.lock.Lock()
+	defer c.resourceSkus.lock.Unlock()
+
+	if c.resourceSkus.skus != nil {
+		return c.resourceSkus.skus, nil
+	}
+
+	skus := make([]compute.ResourceSku, 0)
+	iterator, err := c.resourceSkusClient.ListComplete(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Resource SKUs: %+v", err)
+	}
+	for iterator.NotDone() {
+		skus = append(skus, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Resource SKUs: %+v", err)
+		}
+	}
+
+	c.resourceSkus.skus = skus
+	return skus, nil
+}
@@ -1,16 +1,61 @@
 package azurerm
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
-func TestClientRequestID(t *testing.T) {
-	first := clientRequestID()
+func TestArmClientForSubscription(t *testing.T) {
+	client := &ArmClient{
+		subscriptionId: "00000000-0000-0000-0000-000000000000",
+		clientBuilder: &armClientBuilder{
+			endpoint: "https://management.azure.com/",
+			clients:  make(map[string]*ArmClient),
+		},
+		StopContext: context.Background(),
+	}
+	client.registerClients(client.subscriptionId)
+
+	if client.forSubscription("") != client || client.forSubscription("00000000-0000-0000-0000-000000000000") != client {
+		t.Fatalf("Expected the client for the configured Subscription to be returned")
+	}
+
+	otherSubscriptionId := "11111111-1111-1111-1111-111111111111"
+	first := client.forSubscription(otherSubscriptionId)
+	if first.subscriptionId != otherSubscriptionId || first.vnetPeeringsClient.SubscriptionID != otherSubscriptionId {
+		t.Fatalf("Expected the clients to target Subscription %q but got %q", otherSubscriptionId, first.vnetPeeringsClient.SubscriptionID)
+	}
+	if client.vnetPeeringsClient.SubscriptionID != client.subscriptionId {
+		t.Fatalf("Expected the original clients to be unchanged but got %q", client.vnetPeeringsClient.SubscriptionID)
+	}
+
+	// the clients should be cached, but use the StopContext of the current operation
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	operationClient := *client
+	operationClient.StopContext = ctx
+
+	second := operationClient.forSubscription("11111111-1111-1111-1111-111111111111")
+	if len(client.clientBuilder.clients) != 1 {
+		t.Fatalf("Expected the clients for the Subscription to be cached but got %d", len(client.clientBuilder.clients))
+	}
+	if second.StopContext != ctx || first.StopContext == ctx {
+		t.Fatalf("Expected the StopContext of the current operation to be used")
+	}
+}
 
-	if first == "" {
-		t.Fatal("no client request ID generated")
+func TestSubscriptionIdFromScope(t *testing.T) {
+	cases := map[string]string{
+		"/subscriptions/11111111-1111-1111-1111-111111111111":                        "11111111-1111-1111-1111-111111111111",
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example": "11111111-1111-1111-1111-111111111111",
+		"/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111/resourceGroups/example": "11111111-1111-1111-1111-111111111111",
+		"/providers/Microsoft.Management/managementGroups/example":                   "",
+		"": "",
 	}
 
-	second := clientRequestID()
-	if first != second {
-		t.Fatal("subsequent request ID not the same as the first")
+	for input, expected := range cases {
+		if actual := subscriptionIdFromScope(input); actual != expected {
+			t.Fatalf("Expected %q for %q but got %q", expected, input, actual)
+		}
 	}
 }
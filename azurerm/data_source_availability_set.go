@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -13,6 +15,11 @@ import (
 func dataSourceArmAvailabilitySet() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmAvailabilitySetRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resource_group_name": resourceGroupNameForDataSourceSchema(),
 
@@ -49,7 +56,8 @@ func dataSourceArmAvailabilitySet() *schema.Resource {
 
 func dataSourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).availSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -78,7 +86,7 @@ func dataSourceArmAvailabilitySetRead(d *schema.ResourceData, meta interface{})
 			d.Set("platform_fault_domain_count", strconv.Itoa(int(*v)))
 		}
 	}
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTagsForDataSource(d, resp.Tags, meta)
 
 	return nil
 }
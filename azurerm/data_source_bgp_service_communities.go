@@ -0,0 +1,182 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmBgpServiceCommunities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmBgpServiceCommunitiesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bgp_service_community": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"bgp_community": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"community_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"community_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"community_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"service_supported_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"service_group": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"is_authorized_to_use": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmBgpServiceCommunitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).bgpServiceCommunitiesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	communities := make([]network.BgpServiceCommunity, 0)
+	iterator, err := client.ListComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error listing BGP Service Communities: %+v", err)
+	}
+
+	for iterator.NotDone() {
+		communities = append(communities, iterator.Value())
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing BGP Service Communities: %+v", err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("bgp_service_community", flattenBgpServiceCommunities(communities)); err != nil {
+		return fmt.Errorf("Error setting `bgp_service_community`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenBgpServiceCommunities(input []network.BgpServiceCommunity) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, community := range input {
+		name := ""
+		if community.Name != nil {
+			name = *community.Name
+		}
+
+		serviceName := ""
+		bgpCommunities := make([]interface{}, 0)
+		if props := community.BgpServiceCommunityPropertiesFormat; props != nil {
+			if props.ServiceName != nil {
+				serviceName = *props.ServiceName
+			}
+
+			bgpCommunities = flattenBgpServiceCommunityBgpCommunities(props.BgpCommunities)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":          name,
+			"service_name":  serviceName,
+			"bgp_community": bgpCommunities,
+		})
+	}
+
+	return results
+}
+
+func flattenBgpServiceCommunityBgpCommunities(input *[]network.BGPCommunity) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		communityName := ""
+		if v.CommunityName != nil {
+			communityName = *v.CommunityName
+		}
+
+		communityValue := ""
+		if v.CommunityValue != nil {
+			communityValue = *v.CommunityValue
+		}
+
+		serviceSupportedRegion := ""
+		if v.ServiceSupportedRegion != nil {
+			serviceSupportedRegion = *v.ServiceSupportedRegion
+		}
+
+		serviceGroup := ""
+		if v.ServiceGroup != nil {
+			serviceGroup = *v.ServiceGroup
+		}
+
+		isAuthorizedToUse := false
+		if v.IsAuthorizedToUse != nil {
+			isAuthorizedToUse = *v.IsAuthorizedToUse
+		}
+
+		results = append(results, map[string]interface{}{
+			"community_name":           communityName,
+			"community_value":          communityValue,
+			"community_prefixes":       utils.FlattenStringArray(v.CommunityPrefixes),
+			"service_supported_region": serviceSupportedRegion,
+			"service_group":            serviceGroup,
+			"is_authorized_to_use":     isAuthorizedToUse,
+		})
+	}
+
+	return results
+}
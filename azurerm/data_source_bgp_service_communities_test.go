@@ -0,0 +1,32 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMBgpServiceCommunities_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_bgp_service_communities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMBgpServiceCommunities_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "bgp_service_community.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "bgp_service_community.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "bgp_service_community.0.bgp_community.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMBgpServiceCommunities_basic() string {
+	return `
+data "azurerm_bgp_service_communities" "test" {}
+`
+}
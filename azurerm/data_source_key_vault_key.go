@@ -2,9 +2,12 @@ package azurerm
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -13,6 +16,10 @@ func dataSourceArmKeyVaultKey() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmKeyVaultKeyRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -25,7 +32,7 @@ func dataSourceArmKeyVaultKey() *schema.Resource {
 				Optional:      true, //todo required in 2.0
 				Computed:      true, //todo removed in 2.0
 				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
+				ValidateFunc:  resourceid.ValidateKeyVaultId,
 				ConflictsWith: []string{"vault_uri"},
 			},
 
@@ -81,7 +88,8 @@ func dataSourceArmKeyVaultKey() *schema.Resource {
 func dataSourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	keyVaultBaseUri := d.Get("vault_uri").(string)
 	name := d.Get("name").(string)
@@ -140,7 +148,7 @@ func dataSourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.Set("version", parsedId.Version)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTagsForDataSource(d, resp.Tags, meta)
 
 	return nil
 }
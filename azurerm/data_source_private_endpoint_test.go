@@ -0,0 +1,47 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMPrivateEndpoint_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_private_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMPrivateEndpoint_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subnet_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "private_connection_resource_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "private_ip_addresses.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMPrivateEndpoint_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMPrivateEndpoint_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_endpoint" "test" {
+  name                = "${azurerm_private_endpoint.test.name}"
+  resource_group_name = "${azurerm_private_endpoint.test.resource_group_name}"
+}
+`, template)
+}
@@ -0,0 +1,290 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmResourceSkus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourceSkusRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				StateFunc:        azureRMNormalizeLocation,
+				DiffSuppressFunc: azure.SuppressLocationDiff,
+				ValidateFunc:     validate.NoEmptyStrings,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"skus": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"kind": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"capabilities": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+
+						"locations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"location_info": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"location": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"zones": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+
+						"restrictions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"reason_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"values": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"locations": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"zones": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmResourceSkusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	name := d.Get("name").(string)
+	resourceType := d.Get("resource_type").(string)
+
+	skus, err := client.listResourceSkus(ctx)
+	if err != nil {
+		return err
+	}
+
+	filtered := make([]compute.ResourceSku, 0)
+	for _, sku := range skus {
+		if name != "" && (sku.Name == nil || !strings.EqualFold(*sku.Name, name)) {
+			continue
+		}
+
+		if resourceType != "" && (sku.ResourceType == nil || !strings.EqualFold(*sku.ResourceType, resourceType)) {
+			continue
+		}
+
+		if location != "" && !azure.ResourceSkuSupportsLocation(sku, location) {
+			continue
+		}
+
+		filtered = append(filtered, sku)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("skus", flattenDataSourceResourceSkus(filtered, location)); err != nil {
+		return fmt.Errorf("Error setting `skus`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDataSourceResourceSkus(input []compute.ResourceSku, location string) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, sku := range input {
+		output := map[string]interface{}{
+			"name":          "",
+			"resource_type": "",
+			"tier":          "",
+			"size":          "",
+			"family":        "",
+			"kind":          "",
+		}
+
+		if sku.Name != nil {
+			output["name"] = *sku.Name
+		}
+		if sku.ResourceType != nil {
+			output["resource_type"] = *sku.ResourceType
+		}
+		if sku.Tier != nil {
+			output["tier"] = *sku.Tier
+		}
+		if sku.Size != nil {
+			output["size"] = *sku.Size
+		}
+		if sku.Family != nil {
+			output["family"] = *sku.Family
+		}
+		if sku.Kind != nil {
+			output["kind"] = *sku.Kind
+		}
+
+		capabilities := make(map[string]interface{})
+		if sku.Capabilities != nil {
+			for _, v := range *sku.Capabilities {
+				if v.Name != nil && v.Value != nil {
+					capabilities[*v.Name] = *v.Value
+				}
+			}
+		}
+		output["capabilities"] = capabilities
+
+		locations := make([]interface{}, 0)
+		if sku.Locations != nil {
+			for _, v := range *sku.Locations {
+				if location == "" || azureRMNormalizeLocation(v) == location {
+					locations = append(locations, v)
+				}
+			}
+		}
+		output["locations"] = locations
+
+		locationInfo := make([]interface{}, 0)
+		if sku.LocationInfo != nil {
+			for _, v := range *sku.LocationInfo {
+				if v.Location == nil {
+					continue
+				}
+
+				if location != "" && azureRMNormalizeLocation(*v.Location) != location {
+					continue
+				}
+
+				zones := azure.ResourceSkuAvailableZones(sku, *v.Location)
+
+				locationInfo = append(locationInfo, map[string]interface{}{
+					"location": *v.Location,
+					"zones":    utils.FlattenStringArray(&zones),
+				})
+			}
+		}
+		output["location_info"] = locationInfo
+
+		restrictions := make([]interface{}, 0)
+		if sku.Restrictions != nil {
+			for _, v := range *sku.Restrictions {
+				restriction := map[string]interface{}{
+					"type":        string(v.Type),
+					"reason_code": string(v.ReasonCode),
+					"values":      utils.FlattenStringArray(v.Values),
+					"locations":   make([]interface{}, 0),
+					"zones":       make([]interface{}, 0),
+				}
+
+				if info := v.RestrictionInfo; info != nil {
+					restriction["locations"] = utils.FlattenStringArray(info.Locations)
+					restriction["zones"] = utils.FlattenStringArray(info.Zones)
+				}
+
+				restrictions = append(restrictions, restriction)
+			}
+		}
+		output["restrictions"] = restrictions
+
+		results = append(results, output)
+	}
+
+	return results
+}
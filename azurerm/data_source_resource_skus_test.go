@@ -0,0 +1,68 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMResourceSkus_virtualMachine(t *testing.T) {
+	dataSourceName := "data.azurerm_resource_skus.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResourceSkus_virtualMachine(location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "skus.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "skus.0.name", "Standard_F2"),
+					resource.TestCheckResourceAttr(dataSourceName, "skus.0.resource_type", "virtualMachines"),
+					resource.TestCheckResourceAttr(dataSourceName, "skus.0.locations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "skus.0.capabilities.vCPUs", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResourceSkus_disks(t *testing.T) {
+	dataSourceName := "data.azurerm_resource_skus.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResourceSkus_disks(location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "skus.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "skus.0.resource_type", "disks"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResourceSkus_virtualMachine(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_resource_skus" "test" {
+  location      = "%s"
+  resource_type = "virtualMachines"
+  name          = "Standard_F2"
+}
+`, location)
+}
+
+func testAccDataSourceAzureRMResourceSkus_disks(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_resource_skus" "test" {
+  location      = "%s"
+  resource_type = "disks"
+}
+`, location)
+}
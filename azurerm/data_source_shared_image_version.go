@@ -3,9 +3,11 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -14,6 +16,10 @@ func dataSourceArmSharedImageVersion() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmSharedImageVersionRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -65,6 +71,13 @@ func dataSourceArmSharedImageVersion() *schema.Resource {
 				Computed: true,
 			},
 
+			"replication_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"regional_replication_status": sharedImageVersionRegionalReplicationStatusSchema(),
+
 			"tags": tagsForDataSourceSchema(),
 		},
 	}
@@ -72,7 +85,8 @@ func dataSourceArmSharedImageVersion() *schema.Resource {
 
 func dataSourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	imageVersion := d.Get("name").(string)
 	imageName := d.Get("image_name").(string)
@@ -115,9 +129,15 @@ func dataSourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{
 				}
 			}
 		}
+
+		replicationState, regionalReplicationStatus := flattenSharedImageVersionReplicationStatus(props.ReplicationStatus)
+		d.Set("replication_state", replicationState)
+		if err := d.Set("regional_replication_status", regionalReplicationStatus); err != nil {
+			return fmt.Errorf("Error setting `regional_replication_status`: %+v", err)
+		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTagsForDataSource(d, resp.Tags, meta)
 
 	return nil
 }
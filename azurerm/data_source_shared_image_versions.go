@@ -0,0 +1,215 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+func dataSourceArmSharedImageVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSharedImageVersionsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gallery_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.SharedImageGalleryName,
+			},
+
+			"image_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.SharedImageName,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"tags_filter": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"managed_image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"target_region": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"regional_replica_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"exclude_from_latest": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmSharedImageVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).galleryImageVersionsClient
+	imagesClient := meta.(*ArmClient).galleryImagesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	imageName := d.Get("image_name").(string)
+	galleryName := d.Get("gallery_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tagsFilter := d.Get("tags_filter").(map[string]interface{})
+
+	image, err := imagesClient.Get(ctx, resourceGroup, galleryName, imageName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Shared Image %q (Gallery %q / Resource Group %q): %+v", imageName, galleryName, resourceGroup, err)
+	}
+
+	if image.ID == nil {
+		return fmt.Errorf("Error retrieving Shared Image %q (Gallery %q / Resource Group %q): `id` was nil", imageName, galleryName, resourceGroup)
+	}
+
+	versions := make([]compute.GalleryImageVersion, 0)
+	iterator, err := client.ListByGalleryImageComplete(ctx, resourceGroup, galleryName, imageName)
+	if err != nil {
+		return fmt.Errorf("Error listing Versions of Shared Image %q (Gallery %q / Resource Group %q): %+v", imageName, galleryName, resourceGroup, err)
+	}
+	for iterator.NotDone() {
+		version := iterator.Value()
+		if sharedImageVersionMatchesTags(version.Tags, tagsFilter) {
+			versions = append(versions, version)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Versions of Shared Image %q (Gallery %q / Resource Group %q): %+v", imageName, galleryName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(*image.ID)
+
+	d.Set("image_name", imageName)
+	d.Set("gallery_name", galleryName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if err := d.Set("images", flattenSharedImageVersions(versions)); err != nil {
+		return fmt.Errorf("Error setting `images`: %+v", err)
+	}
+
+	return nil
+}
+
+// sharedImageVersionMatchesTags returns whether the Shared Image Version has all of the tags (with matching values)
+// specified in the filter
+func sharedImageVersionMatchesTags(tags map[string]*string, filter map[string]interface{}) bool {
+	for k, v := range filter {
+		value, ok := tags[k]
+		if !ok || value == nil || *value != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenSharedImageVersions(input []compute.GalleryImageVersion) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, version := range input {
+		output := map[string]interface{}{
+			"id":                  "",
+			"name":                "",
+			"location":            "",
+			"managed_image_id":    "",
+			"target_region":       make([]interface{}, 0),
+			"exclude_from_latest": false,
+		}
+
+		if version.ID != nil {
+			output["id"] = *version.ID
+		}
+
+		if version.Name != nil {
+			output["name"] = *version.Name
+		}
+
+		if version.Location != nil {
+			output["location"] = azureRMNormalizeLocation(*version.Location)
+		}
+
+		if props := version.GalleryImageVersionProperties; props != nil {
+			if profile := props.PublishingProfile; profile != nil {
+				if profile.ExcludeFromLatest != nil {
+					output["exclude_from_latest"] = *profile.ExcludeFromLatest
+				}
+
+				output["target_region"] = flattenSharedImageVersionDataSourceTargetRegions(profile.TargetRegions)
+
+				if source := profile.Source; source != nil && source.ManagedImage != nil && source.ManagedImage.ID != nil {
+					output["managed_image_id"] = *source.ManagedImage.ID
+				}
+			}
+		}
+
+		tags := make(map[string]interface{})
+		for k, v := range version.Tags {
+			if v != nil {
+				tags[k] = *v
+			}
+		}
+		output["tags"] = tags
+
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,161 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestSharedImageVersionMatchesTags(t *testing.T) {
+	tags := map[string]*string{
+		"environment": utils.String("production"),
+		"team":        utils.String("platform"),
+	}
+
+	cases := []struct {
+		Name     string
+		Filter   map[string]interface{}
+		Expected bool
+	}{
+		{
+			Name:     "No Filter",
+			Filter:   map[string]interface{}{},
+			Expected: true,
+		},
+		{
+			Name: "Matching Tag",
+			Filter: map[string]interface{}{
+				"environment": "production",
+			},
+			Expected: true,
+		},
+		{
+			Name: "Matching Tags",
+			Filter: map[string]interface{}{
+				"environment": "production",
+				"team":        "platform",
+			},
+			Expected: true,
+		},
+		{
+			Name: "Different Value",
+			Filter: map[string]interface{}{
+				"environment": "staging",
+			},
+			Expected: false,
+		},
+		{
+			Name: "Missing Tag",
+			Filter: map[string]interface{}{
+				"environment": "production",
+				"cost-center": "ops",
+			},
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual := sharedImageVersionMatchesTags(tags, tc.Filter)
+		if actual != tc.Expected {
+			t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMSharedImageVersions_tagsFilter(t *testing.T) {
+	dataSourceName := "data.azurerm_shared_image_versions.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+	username := "testadmin"
+	password := "Password1234!"
+	hostname := fmt.Sprintf("tftestcustomimagesrc%d", rInt)
+	resourceGroup := fmt.Sprintf("acctestRG-%d", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSharedImageVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				// need to create a vm and then reference it in the image creation
+				Config:  testAccAzureRMSharedImageVersion_setup(rInt, location, username, password, hostname),
+				Destroy: false,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureVMExists("azurerm_virtual_machine.testsource", true),
+					testGeneralizeVMImage(resourceGroup, "testsource", username, password, hostname, "22", location),
+				),
+			},
+			{
+				Config: testAccDataSourceSharedImageVersions_tagsFilter(rInt, location, username, password, hostname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.name", "0.0.2"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.tags.stage", "production"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.managed_image_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.target_region.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSharedImageVersions_tagsFilter(rInt int, location, username, password, hostname string) string {
+	template := testAccAzureRMSharedImageVersion_provision(rInt, location, username, password, hostname)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "first" {
+  name                = "0.0.1"
+  gallery_name        = "${azurerm_shared_image_gallery.test.name}"
+  image_name          = "${azurerm_shared_image.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  managed_image_id    = "${azurerm_image.test.id}"
+
+  target_region {
+    name                   = "${azurerm_resource_group.test.location}"
+    regional_replica_count = 1
+  }
+
+  tags = {
+    stage = "testing"
+  }
+}
+
+resource "azurerm_shared_image_version" "second" {
+  name                = "0.0.2"
+  gallery_name        = "${azurerm_shared_image_gallery.test.name}"
+  image_name          = "${azurerm_shared_image.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  managed_image_id    = "${azurerm_image.test.id}"
+
+  target_region {
+    name                   = "${azurerm_resource_group.test.location}"
+    regional_replica_count = 1
+  }
+
+  tags = {
+    stage = "production"
+  }
+}
+
+data "azurerm_shared_image_versions" "test" {
+  gallery_name        = "${azurerm_shared_image_gallery.test.name}"
+  image_name          = "${azurerm_shared_image.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags_filter = {
+    stage = "production"
+  }
+
+  depends_on = ["azurerm_shared_image_version.first", "azurerm_shared_image_version.second"]
+}
+`, template)
+}
@@ -0,0 +1,247 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualMachineScaleSetInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualMachineScaleSetInstancesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"computer_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"latest_model_applied": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"virtual_machine_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmVirtualMachineScaleSetInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	scaleSetsClient := meta.(*ArmClient).vmScaleSetClient
+	vmsClient := meta.(*ArmClient).vmScaleSetVMsClient
+	interfacesClient := meta.(*ArmClient).ifaceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	scaleSet, err := scaleSetsClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(scaleSet.Response) {
+			return fmt.Errorf("Error: Virtual Machine Scale Set %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if scaleSet.ID == nil {
+		return fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	log.Printf("[DEBUG] Listing the instances of Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+	vms := make([]compute.VirtualMachineScaleSetVM, 0)
+	vmsIterator, err := vmsClient.ListComplete(ctx, resourceGroup, name, "", "", "")
+	if err != nil {
+		return fmt.Errorf("Error listing instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for vmsIterator.NotDone() {
+		vms = append(vms, vmsIterator.Value())
+		if err := vmsIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Listing the Network Interfaces of Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+	interfaces := make([]network.Interface, 0)
+	interfacesIterator, err := interfacesClient.ListVirtualMachineScaleSetNetworkInterfacesComplete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error listing Network Interfaces of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for interfacesIterator.NotDone() {
+		interfaces = append(interfaces, interfacesIterator.Value())
+		if err := interfacesIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Network Interfaces of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	d.SetId(*scaleSet.ID)
+
+	if err := d.Set("instances", flattenDataSourceVirtualMachineScaleSetInstances(vms, interfaces)); err != nil {
+		return fmt.Errorf("Error setting `instances`: %+v", err)
+	}
+
+	return nil
+}
+
+// flattenDataSourceVirtualMachineScaleSetInstances flattens the instances of a Virtual Machine Scale Set, where the
+// Private IP Addresses of each instance are sourced from the Network Interfaces attached to that instance
+func flattenDataSourceVirtualMachineScaleSetInstances(vms []compute.VirtualMachineScaleSetVM, interfaces []network.Interface) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, vm := range vms {
+		output := map[string]interface{}{
+			"id":                   "",
+			"instance_id":          "",
+			"name":                 "",
+			"computer_name":        "",
+			"latest_model_applied": false,
+			"private_ip_address":   "",
+			"private_ip_addresses": make([]interface{}, 0),
+			"virtual_machine_id":   "",
+			"zone":                 "",
+		}
+
+		if vm.ID != nil {
+			output["id"] = *vm.ID
+		}
+
+		if vm.InstanceID != nil {
+			output["instance_id"] = *vm.InstanceID
+		}
+
+		if vm.Name != nil {
+			output["name"] = *vm.Name
+		}
+
+		if zones := vm.Zones; zones != nil && len(*zones) > 0 {
+			output["zone"] = (*zones)[0]
+		}
+
+		if props := vm.VirtualMachineScaleSetVMProperties; props != nil {
+			if props.LatestModelApplied != nil {
+				output["latest_model_applied"] = *props.LatestModelApplied
+			}
+
+			if props.VMID != nil {
+				output["virtual_machine_id"] = *props.VMID
+			}
+
+			if osProfile := props.OsProfile; osProfile != nil && osProfile.ComputerName != nil {
+				output["computer_name"] = *osProfile.ComputerName
+			}
+		}
+
+		if vm.ID != nil {
+			primaryAddress, addresses := flattenDataSourceVirtualMachineScaleSetInstancePrivateIPAddresses(*vm.ID, interfaces)
+			output["private_ip_address"] = primaryAddress
+			output["private_ip_addresses"] = addresses
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenDataSourceVirtualMachineScaleSetInstancePrivateIPAddresses(vmId string, interfaces []network.Interface) (string, []interface{}) {
+	primaryAddress := ""
+	addresses := make([]interface{}, 0)
+
+	for _, iface := range interfaces {
+		props := iface.InterfacePropertiesFormat
+		if props == nil || props.VirtualMachine == nil || props.VirtualMachine.ID == nil {
+			continue
+		}
+
+		if !strings.EqualFold(*props.VirtualMachine.ID, vmId) {
+			continue
+		}
+
+		primaryInterface := props.Primary != nil && *props.Primary
+		if configs := props.IPConfigurations; configs != nil {
+			for _, config := range *configs {
+				configProps := config.InterfaceIPConfigurationPropertiesFormat
+				if configProps == nil || configProps.PrivateIPAddress == nil {
+					continue
+				}
+
+				address := *configProps.PrivateIPAddress
+				addresses = append(addresses, address)
+
+				if primaryInterface && configProps.Primary != nil && *configProps.Primary {
+					primaryAddress = address
+				}
+			}
+		}
+	}
+
+	// when there's no Primary Network Interface, fall back to the first Private IP Address
+	if primaryAddress == "" && len(addresses) > 0 {
+		primaryAddress = addresses[0].(string)
+	}
+
+	return primaryAddress, addresses
+}
@@ -0,0 +1,148 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenDataSourceVirtualMachineScaleSetInstances(t *testing.T) {
+	scaleSetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1"
+	networkInterface := func(vmId string, primary bool, addresses ...string) network.Interface {
+		configs := make([]network.InterfaceIPConfiguration, 0)
+		for i, address := range addresses {
+			configs = append(configs, network.InterfaceIPConfiguration{
+				InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+					Primary:          utils.Bool(i == 0),
+					PrivateIPAddress: utils.String(address),
+				},
+			})
+		}
+		return network.Interface{
+			InterfacePropertiesFormat: &network.InterfacePropertiesFormat{
+				Primary:          utils.Bool(primary),
+				VirtualMachine:   &network.SubResource{ID: utils.String(vmId)},
+				IPConfigurations: &configs,
+			},
+		}
+	}
+
+	vms := []compute.VirtualMachineScaleSetVM{
+		{
+			ID:         utils.String(scaleSetId + "/virtualMachines/0"),
+			InstanceID: utils.String("0"),
+			Name:       utils.String("scaleSet1_0"),
+			Zones:      &[]string{"1"},
+			VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+				LatestModelApplied: utils.Bool(true),
+				VMID:               utils.String("11111111-1111-1111-1111-111111111111"),
+				OsProfile: &compute.OSProfile{
+					ComputerName: utils.String("scaleset1000000"),
+				},
+			},
+		},
+		{
+			ID:         utils.String(scaleSetId + "/virtualMachines/2"),
+			InstanceID: utils.String("2"),
+			Name:       utils.String("scaleSet1_2"),
+			VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+				LatestModelApplied: utils.Bool(false),
+			},
+		},
+		{
+			// an instance which is still being provisioned and has no Network Interfaces yet
+			ID:         utils.String(scaleSetId + "/virtualMachines/3"),
+			InstanceID: utils.String("3"),
+		},
+	}
+
+	interfaces := []network.Interface{
+		// the casing of the Virtual Machine ID returned from the Network API can differ from the Compute API
+		networkInterface(scaleSetId+"/VIRTUALMACHINES/2", false, "10.0.1.6"),
+		networkInterface(scaleSetId+"/virtualMachines/0", true, "10.0.0.4", "10.0.0.5"),
+		networkInterface(scaleSetId+"/virtualMachines/2", true, "10.0.0.6"),
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":                   scaleSetId + "/virtualMachines/0",
+			"instance_id":          "0",
+			"name":                 "scaleSet1_0",
+			"computer_name":        "scaleset1000000",
+			"latest_model_applied": true,
+			"private_ip_address":   "10.0.0.4",
+			"private_ip_addresses": []interface{}{"10.0.0.4", "10.0.0.5"},
+			"virtual_machine_id":   "11111111-1111-1111-1111-111111111111",
+			"zone":                 "1",
+		},
+		map[string]interface{}{
+			"id":                   scaleSetId + "/virtualMachines/2",
+			"instance_id":          "2",
+			"name":                 "scaleSet1_2",
+			"computer_name":        "",
+			"latest_model_applied": false,
+			"private_ip_address":   "10.0.0.6",
+			"private_ip_addresses": []interface{}{"10.0.1.6", "10.0.0.6"},
+			"virtual_machine_id":   "",
+			"zone":                 "",
+		},
+		map[string]interface{}{
+			"id":                   scaleSetId + "/virtualMachines/3",
+			"instance_id":          "3",
+			"name":                 "",
+			"computer_name":        "",
+			"latest_model_applied": false,
+			"private_ip_address":   "",
+			"private_ip_addresses": []interface{}{},
+			"virtual_machine_id":   "",
+			"zone":                 "",
+		},
+	}
+
+	actual := flattenDataSourceVirtualMachineScaleSetInstances(vms, interfaces)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestAccDataSourceAzureRMVirtualMachineScaleSetInstances_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_machine_scale_set_instances.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVirtualMachineScaleSetInstances_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "3"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.instance_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.computer_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.private_ip_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.latest_model_applied", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.1.private_ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualMachineScaleSetInstances_basic(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachineScaleSet_manualUpdateInstances(rInt, location, "Standard_F2")
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine_scale_set_instances" "test" {
+  name                = "${azurerm_linux_virtual_machine_scale_set.test.name}"
+  resource_group_name = "${azurerm_linux_virtual_machine_scale_set.test.resource_group_name}"
+}
+`, template)
+}
@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMVirtualWan_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_wan.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualWanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVirtualWan_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttr(dataSourceName, "allow_branch_to_branch_traffic", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "office365_local_breakout_category", "None"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualWan_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualWan_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_wan" "test" {
+  name                = "${azurerm_virtual_wan.test.name}"
+  resource_group_name = "${azurerm_virtual_wan.test.resource_group_name}"
+}
+`, template)
+}
@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMVpnGateway_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVpnGateway_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "virtual_hub_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "scale_unit", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "bgp_settings.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVpnGateway_basic(rInt int, location string) string {
+	template := testAccAzureRMVpnGateway_basic(rInt, location, 1)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_vpn_gateway" "test" {
+  name                = "${azurerm_vpn_gateway.test.name}"
+  resource_group_name = "${azurerm_vpn_gateway.test.resource_group_name}"
+}
+`, template)
+}
@@ -0,0 +1,158 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVpnSite() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVpnSiteRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"virtual_wan_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"address_prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"device_vendor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"device_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"link_speed_in_mbps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"bgp_peering_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"peer_weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"is_security_site": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmVpnSiteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnSiteClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: VPN Site %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error retrieving VPN Site %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VpnSiteProperties; props != nil {
+		virtualWanId := ""
+		if props.VirtualWan != nil && props.VirtualWan.ID != nil {
+			virtualWanId = *props.VirtualWan.ID
+		}
+		d.Set("virtual_wan_id", virtualWanId)
+		d.Set("ip_address", props.IPAddress)
+		d.Set("is_security_site", props.IsSecuritySite)
+
+		addressPrefixes := make([]interface{}, 0)
+		if space := props.AddressSpace; space != nil {
+			addressPrefixes = utils.FlattenStringArray(space.AddressPrefixes)
+		}
+		if err := d.Set("address_prefixes", addressPrefixes); err != nil {
+			return fmt.Errorf("Error setting `address_prefixes`: %+v", err)
+		}
+
+		if device := props.DeviceProperties; device != nil {
+			d.Set("device_vendor", device.DeviceVendor)
+			d.Set("device_model", device.DeviceModel)
+			if device.LinkSpeedInMbps != nil {
+				d.Set("link_speed_in_mbps", int(*device.LinkSpeedInMbps))
+			}
+		}
+
+		if err := d.Set("bgp_settings", flattenVpnSiteBgpSettings(props.BgpProperties)); err != nil {
+			return fmt.Errorf("Error setting `bgp_settings`: %+v", err)
+		}
+	}
+
+	flattenAndSetTagsForDataSource(d, resp.Tags, meta)
+
+	return nil
+}
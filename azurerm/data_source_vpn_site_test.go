@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccDataSourceAzureRMVpnSite_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMVpnSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "virtual_wan_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ip_address", "203.0.113.10"),
+					resource.TestCheckResourceAttr(dataSourceName, "address_prefixes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVpnSite_basic(rInt int, location string) string {
+	template := testAccAzureRMVpnSite_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_vpn_site" "test" {
+  name                = "${azurerm_vpn_site.test.name}"
+  resource_group_name = "${azurerm_vpn_site.test.resource_group_name}"
+}
+`, template)
+}
@@ -0,0 +1,59 @@
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const auxiliaryAuthorizationHeader = "x-ms-authorization-auxiliary"
+
+// MaxAuxiliaryTenants is the maximum number of Auxiliary Tenants which Azure Resource Manager supports
+const MaxAuxiliaryTenants = 3
+
+type auxiliaryTenantsAuthorizer struct {
+	primary   autorest.Authorizer
+	auxiliary []autorest.Authorizer
+}
+
+// AuxiliaryTenantsAuthorizer returns an Authorizer which, in addition to the token for the primary Tenant, sends
+// the tokens for the Auxiliary Tenants in the `x-ms-authorization-auxiliary` header - which allows for operations
+// which span multiple Tenants (for example peering to a Virtual Network in another Tenant)
+func AuxiliaryTenantsAuthorizer(primary autorest.Authorizer, auxiliary []autorest.Authorizer) autorest.Authorizer {
+	if len(auxiliary) == 0 {
+		return primary
+	}
+
+	return auxiliaryTenantsAuthorizer{
+		primary:   primary,
+		auxiliary: auxiliary,
+	}
+}
+
+func (a auxiliaryTenantsAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := a.primary.WithAuthorization()(p).Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			tokens := make([]string, 0, len(a.auxiliary))
+			for _, auth := range a.auxiliary {
+				// the Authorizers only expose (and refresh) their tokens by preparing a request
+				tokenRequest := (&http.Request{Header: http.Header{}}).WithContext(r.Context())
+				tokenRequest, err := autorest.Prepare(tokenRequest, auth.WithAuthorization())
+				if err != nil {
+					return r, fmt.Errorf("Error obtaining the token for an Auxiliary Tenant: %+v", err)
+				}
+
+				tokens = append(tokens, tokenRequest.Header.Get("Authorization"))
+			}
+
+			r.Header.Set(auxiliaryAuthorizationHeader, strings.Join(tokens, ", "))
+			return r, nil
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func TestAuxiliaryTenantsAuthorizer(t *testing.T) {
+	primary := autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "primary"})
+	auxiliary := []autorest.Authorizer{
+		autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "first"}),
+		autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "second"}),
+	}
+
+	if AuxiliaryTenantsAuthorizer(primary, nil) != primary {
+		t.Fatalf("Expected the primary Authorizer to be used when there are no Auxiliary Tenants")
+	}
+
+	req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions", nil)
+	req, err := autorest.Prepare(req, AuxiliaryTenantsAuthorizer(primary, auxiliary).WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error preparing request: %+v", err)
+	}
+
+	if v := req.Header.Get("Authorization"); v != "Bearer primary" {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer primary", v)
+	}
+	if v := req.Header.Get(auxiliaryAuthorizationHeader); v != "Bearer first, Bearer second" {
+		t.Fatalf("Expected the %s header to be %q but got %q", auxiliaryAuthorizationHeader, "Bearer first, Bearer second", v)
+	}
+}
@@ -0,0 +1,90 @@
+package azure
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-multierror"
+)
+
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// ClientAssertionAuth authenticates as a Service Principal using a federated token (such as an
+// OIDC token issued to a CI system) as a Client Assertion, rather than a Client Secret or Certificate
+type ClientAssertionAuth struct {
+	ClientId string
+	TenantId string
+
+	// Token is the JWT used as the Client Assertion
+	Token string
+
+	// TokenFilePath is the path to a file containing the JWT used as the Client Assertion
+	TokenFilePath string
+}
+
+// Validate ensures all of the required fields are set
+func (a ClientAssertionAuth) Validate() error {
+	var err *multierror.Error
+
+	fmtErrorMessage := "A %s must be configured when authenticating as a Service Principal using an OIDC Token."
+
+	if a.ClientId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Client ID"))
+	}
+	if a.TenantId == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "Tenant ID"))
+	}
+	if a.Token == "" && a.TokenFilePath == "" {
+		err = multierror.Append(err, fmt.Errorf(fmtErrorMessage, "OIDC Token or OIDC Token File Path"))
+	}
+	if a.Token != "" && a.TokenFilePath != "" {
+		err = multierror.Append(err, fmt.Errorf("Only one of the OIDC Token or OIDC Token File Path can be configured."))
+	}
+
+	return err.ErrorOrNil()
+}
+
+// GetAuthorizationToken returns an Authorizer which exchanges the Client Assertion for an access token for the endpoint
+func (a ClientAssertionAuth) GetAuthorizationToken(oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error) {
+	secret := &clientAssertionSecret{
+		token:         a.Token,
+		tokenFilePath: a.TokenFilePath,
+	}
+	spt, err := adal.NewServicePrincipalTokenWithSecret(*oauthConfig, a.ClientId, endpoint, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+type clientAssertionSecret struct {
+	token         string
+	tokenFilePath string
+}
+
+func (s *clientAssertionSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, v *url.Values) error {
+	token := s.token
+
+	// the file is read each time a token is requested, since it's rotated by the platform which issues it
+	if s.tokenFilePath != "" {
+		contents, err := ioutil.ReadFile(s.tokenFilePath)
+		if err != nil {
+			return fmt.Errorf("Error reading OIDC Token from %q: %+v", s.tokenFilePath, err)
+		}
+
+		token = strings.TrimSpace(string(contents))
+	}
+
+	if token == "" {
+		return fmt.Errorf("The OIDC Token used as the Client Assertion was empty")
+	}
+
+	v.Set("client_assertion", token)
+	v.Set("client_assertion_type", clientAssertionType)
+	return nil
+}
@@ -0,0 +1,102 @@
+package azure
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func TestClientAssertionAuth_Validate(t *testing.T) {
+	cases := []struct {
+		auth  ClientAssertionAuth
+		valid bool
+	}{
+		{
+			auth:  ClientAssertionAuth{ClientId: "client", TenantId: "tenant", Token: "jwt"},
+			valid: true,
+		},
+		{
+			auth:  ClientAssertionAuth{ClientId: "client", TenantId: "tenant", TokenFilePath: "/var/run/token"},
+			valid: true,
+		},
+		{
+			auth:  ClientAssertionAuth{ClientId: "client", TenantId: "tenant"},
+			valid: false,
+		},
+		{
+			auth:  ClientAssertionAuth{ClientId: "client", TenantId: "tenant", Token: "jwt", TokenFilePath: "/var/run/token"},
+			valid: false,
+		},
+		{
+			auth:  ClientAssertionAuth{TenantId: "tenant", Token: "jwt"},
+			valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.auth.Validate()
+		if v.valid && err != nil {
+			t.Fatalf("Expected %+v to be valid but got %+v", v.auth, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("Expected %+v to be invalid", v.auth)
+		}
+	}
+}
+
+func TestClientAssertionAuth_GetAuthorizationToken(t *testing.T) {
+	file, err := ioutil.TempFile("", "oidc-token")
+	if err != nil {
+		t.Fatalf("Error creating temporary file: %+v", err)
+	}
+	defer os.Remove(file.Name())
+	file.WriteString("jwt-from-file\n") // nolint: errcheck
+	file.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Error parsing form: %+v", err)
+		}
+
+		if r.Form.Get("client_assertion") != "jwt-from-file" || r.Form.Get("client_assertion_type") != clientAssertionType || r.Form.Get("client_id") != "client" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access-token","token_type":"Bearer","expires_in":"3600","expires_on":"%d","resource":"%s"}`, time.Now().Add(time.Hour).Unix(), r.Form.Get("resource"))
+	}))
+	defer server.Close()
+
+	oauthConfig, err := adal.NewOAuthConfig(server.URL, "tenant")
+	if err != nil {
+		t.Fatalf("Error building OAuthConfig: %+v", err)
+	}
+
+	auth := ClientAssertionAuth{
+		ClientId:      "client",
+		TenantId:      "tenant",
+		TokenFilePath: file.Name(),
+	}
+	authorizer, err := auth.GetAuthorizationToken(oauthConfig, "https://management.azure.com/")
+	if err != nil {
+		t.Fatalf("Error building Authorizer: %+v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions", nil)
+	req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error obtaining token: %+v", err)
+	}
+
+	if v := req.Header.Get("Authorization"); v != "Bearer access-token" {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer access-token", v)
+	}
+}
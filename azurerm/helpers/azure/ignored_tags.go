@@ -0,0 +1,35 @@
+package azure
+
+import (
+	"strings"
+)
+
+// IgnoredTags are the tags which are managed outside of Terraform (for example by Azure Policy) - and as
+// such are neither reported nor removed by the Provider
+type IgnoredTags struct {
+	Keys     []string
+	Prefixes []string
+}
+
+// IsEmpty returns whether no tags are ignored
+func (t IgnoredTags) IsEmpty() bool {
+	return len(t.Keys) == 0 && len(t.Prefixes) == 0
+}
+
+// IsIgnored returns whether the tag with the specified key is ignored, which is case-insensitive
+// since this is how Azure treats tag keys
+func (t IgnoredTags) IsIgnored(key string) bool {
+	for _, v := range t.Keys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range t.Prefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,27 @@
+package azure
+
+import (
+	"testing"
+)
+
+func TestIgnoredTags_IsIgnored(t *testing.T) {
+	ignoredTags := IgnoredTags{
+		Keys:     []string{"CreatedBy"},
+		Prefixes: []string{"policy:"},
+	}
+
+	cases := map[string]bool{
+		"CreatedBy":         true,
+		"createdby":         true,
+		"CreatedByUser":     false,
+		"policy:compliance": true,
+		"Policy:Owner":      true,
+		"environment":       false,
+	}
+
+	for key, expected := range cases {
+		if actual := ignoredTags.IsIgnored(key); actual != expected {
+			t.Fatalf("Expected IsIgnored(%q) to be %t but got %t", key, expected, actual)
+		}
+	}
+}
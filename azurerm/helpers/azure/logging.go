@@ -0,0 +1,240 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// RequestLogFormatEnvVar is the Environment Variable used to configure the format requests are logged in
+	RequestLogFormatEnvVar = "ARM_REQUEST_LOG_FORMAT"
+
+	// RequestLogPathEnvVar is the Environment Variable containing the path of a file which requests should be
+	// logged to when using the JSON format - by default these are written to the Terraform log
+	RequestLogPathEnvVar = "ARM_REQUEST_LOG_PATH"
+)
+
+type RequestLogFormat string
+
+const (
+	RequestLogFormatText RequestLogFormat = "text"
+	RequestLogFormatJSON RequestLogFormat = "json"
+)
+
+// sensitiveHeaderNames are the headers which are removed prior to logging a request or response
+var sensitiveHeaderNames = []string{
+	"Authorization",
+	"Ocp-Apim-Subscription-Key",
+	"x-ms-authorization-auxiliary",
+}
+
+// CurrentRequestLogFormat returns the format which requests should be logged in, based on the
+// `ARM_REQUEST_LOG_FORMAT` Environment Variable
+func CurrentRequestLogFormat() RequestLogFormat {
+	switch format := RequestLogFormat(strings.ToLower(os.Getenv(RequestLogFormatEnvVar))); format {
+	case "", RequestLogFormatText:
+		return RequestLogFormatText
+	case RequestLogFormatJSON:
+		return RequestLogFormatJSON
+	default:
+		log.Printf("[WARN] Unsupported value %q for %q - requests will be logged as text", format, RequestLogFormatEnvVar)
+		return RequestLogFormatText
+	}
+}
+
+// requestLogEntry is a single line written when requests are logged in the JSON format
+type requestLogEntry struct {
+	Time                 string  `json:"time"`
+	Method               string  `json:"method"`
+	URL                  string  `json:"url"`
+	StatusCode           int     `json:"status_code,omitempty"`
+	DurationMs           float64 `json:"duration_ms"`
+	ClientRequestId      string  `json:"client_request_id,omitempty"`
+	CorrelationRequestId string  `json:"correlation_request_id,omitempty"`
+	Error                string  `json:"error,omitempty"`
+}
+
+var (
+	requestLogWriterOnce  sync.Once
+	requestLogWriterValue io.Writer
+	requestLogWriterLock  sync.Mutex
+)
+
+// requestLogWriter returns the file JSON log entries should be written to, or nil if these
+// should be written to the Terraform log
+func requestLogWriter() io.Writer {
+	requestLogWriterOnce.Do(func() {
+		path := os.Getenv(RequestLogPathEnvVar)
+		if path == "" {
+			return
+		}
+
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Printf("[WARN] Unable to open %q to log requests to: %+v", path, err)
+			return
+		}
+
+		requestLogWriterValue = file
+	})
+
+	return requestLogWriterValue
+}
+
+func withRequestLogging() autorest.SendDecorator {
+	if CurrentRequestLogFormat() == RequestLogFormatJSON {
+		return withJSONRequestLogging(requestLogWriter())
+	}
+
+	return withTextRequestLogging()
+}
+
+func withTextRequestLogging() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// dump request to wire format
+			if dump, err := dumpRequest(r); err == nil {
+				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
+			} else {
+				// fallback to basic message
+				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				// dump response to wire format
+				if dump, err2 := dumpResponse(resp); err2 == nil {
+					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
+				} else {
+					// fallback to basic message
+					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
+				}
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
+			}
+			return resp, err
+		})
+	}
+}
+
+// withJSONRequestLogging writes a single JSON line per request to the writer, or to the Terraform log if it's nil
+func withJSONRequestLogging(w io.Writer) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := s.Do(r)
+
+			entry := requestLogEntry{
+				Time:            start.UTC().Format(time.RFC3339Nano),
+				Method:          r.Method,
+				URL:             r.URL.String(),
+				DurationMs:      float64(time.Since(start).Nanoseconds()) / float64(time.Millisecond),
+				ClientRequestId: r.Header.Get(clientRequestIdHeader),
+			}
+			if resp != nil {
+				entry.StatusCode = resp.StatusCode
+				entry.CorrelationRequestId = resp.Header.Get(correlationRequestIdHeader)
+			}
+			if err != nil {
+				entry.Error = err.Error()
+			}
+
+			line, jsonErr := json.Marshal(entry)
+			if jsonErr != nil {
+				log.Printf("[DEBUG] Unable to serialize the log entry for %s %s: %+v", r.Method, r.URL, jsonErr)
+				return resp, err
+			}
+
+			if w == nil {
+				log.Printf("[DEBUG] %s", line)
+				return resp, err
+			}
+
+			requestLogWriterLock.Lock()
+			defer requestLogWriterLock.Unlock()
+			if _, writeErr := fmt.Fprintf(w, "%s\n", line); writeErr != nil {
+				log.Printf("[DEBUG] Unable to write the log entry for %s %s: %+v", r.Method, r.URL, writeErr)
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// dumpRequest returns the request in wire format, with any secrets in the headers or body redacted
+func dumpRequest(r *http.Request) ([]byte, error) {
+	// strip the sensitive headers prior to printing
+	removed := removeSensitiveHeaders(r.Header)
+	defer restoreHeaders(r.Header, removed)
+
+	dump, err := httputil.DumpRequestOut(r, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Body == nil {
+		return dump, nil
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return append(dump, RedactJSON(body)...), nil
+}
+
+// dumpResponse returns the response in wire format, with any secrets in the body redacted
+func dumpResponse(resp *http.Response) ([]byte, error) {
+	removed := removeSensitiveHeaders(resp.Header)
+	defer restoreHeaders(resp.Header, removed)
+
+	dump, err := httputil.DumpResponse(resp, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Body == nil {
+		return dump, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return append(dump, RedactJSON(body)...), nil
+}
+
+func removeSensitiveHeaders(headers http.Header) map[string][]string {
+	removed := make(map[string][]string)
+	for _, name := range sensitiveHeaderNames {
+		if values := headers[http.CanonicalHeaderKey(name)]; len(values) > 0 {
+			removed[name] = values
+			headers.Del(name)
+		}
+	}
+	return removed
+}
+
+func restoreHeaders(headers http.Header, values map[string][]string) {
+	for name, v := range values {
+		headers[http.CanonicalHeaderKey(name)] = v
+	}
+}
@@ -0,0 +1,91 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTextRequestLogging_RedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"keys":[{"keyName":"key1","value":"super-secret","permissions":"Full"}]}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	sender := withTextRequestLogging()(http.DefaultClient)
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/listKeys", strings.NewReader(`{"properties":{"administratorLoginPassword":"P@ssword1234!"}}`))
+	req.Header.Set("Authorization", "Bearer abc123")
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+
+	// the request/response should be unchanged once logged
+	if req.Header.Get("Authorization") != "Bearer abc123" {
+		t.Fatalf("Expected the Authorization header to be restored but got %q", req.Header.Get("Authorization"))
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "super-secret") {
+		t.Fatalf("Expected the response body to be unchanged but got %q", string(body))
+	}
+
+	for _, unexpected := range []string{"abc123", "super-secret", "P@ssword1234!"} {
+		if strings.Contains(output.String(), unexpected) {
+			t.Fatalf("Expected %q to be redacted from the log but got: %s", unexpected, output.String())
+		}
+	}
+	if !strings.Contains(output.String(), `"keyName":"key1"`) {
+		t.Fatalf("Expected the non-sensitive fields to be logged but got: %s", output.String())
+	}
+}
+
+func TestJSONRequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(correlationRequestIdHeader, "correlation")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"properties":{"clientSecret":"super-secret"}}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	sender := withJSONRequestLogging(&output)(http.DefaultClient)
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodPut, server.URL+"/resourceGroups/example", nil)
+		req.Header.Set(clientRequestIdHeader, "client")
+		if _, err := sender.Do(req); err != nil {
+			t.Fatalf("Error sending request: %+v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected a line per request but got %d: %s", len(lines), output.String())
+	}
+
+	var entry requestLogEntry
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("Error parsing log entry %q: %+v", lines[0], err)
+	}
+
+	if entry.Method != http.MethodPut || entry.URL != server.URL+"/resourceGroups/example" || entry.StatusCode != http.StatusConflict {
+		t.Fatalf("Expected the Method, URL and Status Code to be logged but got %+v", entry)
+	}
+	if entry.ClientRequestId != "client" || entry.CorrelationRequestId != "correlation" {
+		t.Fatalf("Expected the Request ID's to be logged but got %+v", entry)
+	}
+	if strings.Contains(output.String(), "super-secret") {
+		t.Fatalf("Expected the body not to be logged but got: %s", output.String())
+	}
+}
@@ -0,0 +1,316 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	// RecordingModeEnvVar is the Environment Variable used to toggle recording/replaying requests
+	RecordingModeEnvVar = "ARM_TEST_RECORDING_MODE"
+
+	// CassetteEnvVar is the Environment Variable containing the path to the Cassette used to
+	// record/replay requests
+	CassetteEnvVar = "ARM_TEST_CASSETTE"
+)
+
+type RecordingMode string
+
+const (
+	RecordingModeDisabled RecordingMode = ""
+	RecordingModeRecord   RecordingMode = "record"
+	RecordingModeReplay   RecordingMode = "replay"
+)
+
+const placeholderId = "00000000-0000-0000-0000-000000000000"
+
+// placeholderRandomIntBase is the first value handed out instead of a random integer when replaying
+// requests - this has the same number of digits as the values returned from `tf.AccRandTimeInt`
+const placeholderRandomIntBase = 100000000000000000
+
+// recordedResponseHeaders are the response headers stored in a Cassette, all others are discarded
+var recordedResponseHeaders = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"Location",
+}
+
+// CurrentRecordingMode returns whether requests should be recorded/replayed, based on the
+// `ARM_TEST_RECORDING_MODE` Environment Variable
+func CurrentRecordingMode() RecordingMode {
+	switch mode := RecordingMode(strings.ToLower(os.Getenv(RecordingModeEnvVar))); mode {
+	case RecordingModeDisabled, RecordingModeRecord, RecordingModeReplay:
+		return mode
+	default:
+		log.Printf("[WARN] Unsupported value %q for %q - requests will not be recorded or replayed", mode, RecordingModeEnvVar)
+		return RecordingModeDisabled
+	}
+}
+
+// RecordRandomInt registers a random value generated for an Acceptance Test. When recording, occurrences
+// of this value are replaced by a stable placeholder in the Cassette - when replaying, the placeholder
+// is returned so that the requests made by the test match those which were recorded.
+func RecordRandomInt(value int) int {
+	mode := CurrentRecordingMode()
+	if mode == RecordingModeDisabled {
+		return value
+	}
+
+	c, err := activeCassette()
+	if err != nil {
+		log.Printf("[WARN] Unable to load the Cassette: %+v", err)
+		return value
+	}
+
+	placeholder := c.nextPlaceholder(strconv.Itoa(value))
+	if mode == RecordingModeReplay {
+		return placeholder
+	}
+
+	return value
+}
+
+type cassette struct {
+	Interactions []cassetteInteraction `json:"interactions"`
+
+	path         string
+	lock         sync.Mutex
+	used         []bool
+	placeholders map[string]string
+	replacer     *strings.Replacer
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+var (
+	activeCassetteOnce  sync.Once
+	activeCassetteValue *cassette
+	activeCassetteError error
+)
+
+// activeCassette returns the Cassette used by every Sender in this process
+func activeCassette() (*cassette, error) {
+	activeCassetteOnce.Do(func() {
+		path := os.Getenv(CassetteEnvVar)
+		if path == "" {
+			activeCassetteError = fmt.Errorf("%q must be set when %q is set", CassetteEnvVar, RecordingModeEnvVar)
+			return
+		}
+
+		activeCassetteValue, activeCassetteError = loadCassette(path, CurrentRecordingMode())
+	})
+
+	return activeCassetteValue, activeCassetteError
+}
+
+func loadCassette(path string, mode RecordingMode) (*cassette, error) {
+	c := &cassette{
+		path:         path,
+		placeholders: make(map[string]string),
+	}
+
+	if mode == RecordingModeReplay {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading Cassette %q: %+v", path, err)
+		}
+
+		if err := json.Unmarshal(contents, c); err != nil {
+			return nil, fmt.Errorf("Error parsing Cassette %q: %+v", path, err)
+		}
+
+		c.used = make([]bool, len(c.Interactions))
+	}
+
+	c.buildReplacer()
+	return c, nil
+}
+
+// nextPlaceholder returns the placeholder for the next random value used by a test
+func (c *cassette) nextPlaceholder(value string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	placeholder := placeholderRandomIntBase + len(c.placeholders) + 1
+	c.placeholders[value] = strconv.Itoa(placeholder)
+	c.buildReplacer()
+
+	return placeholder
+}
+
+func (c *cassette) buildReplacer() {
+	replacements := make([]string, 0)
+	for _, envVar := range []string{"ARM_SUBSCRIPTION_ID", "ARM_TENANT_ID", "ARM_CLIENT_ID"} {
+		if v := os.Getenv(envVar); v != "" && v != placeholderId {
+			replacements = append(replacements, v, placeholderId)
+		}
+	}
+	for value, placeholder := range c.placeholders {
+		replacements = append(replacements, value, placeholder)
+	}
+
+	c.replacer = strings.NewReplacer(replacements...)
+}
+
+// scrub replaces the identifiers of the account and the random values used in a test
+func (c *cassette) scrub(input string) string {
+	return c.replacer.Replace(input)
+}
+
+func (c *cassette) record(r *http.Request, requestBody []byte, resp *http.Response, responseBody []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	headers := make(map[string]string)
+	for _, header := range recordedResponseHeaders {
+		if v := resp.Header.Get(header); v != "" {
+			headers[header] = c.scrub(v)
+		}
+	}
+
+	c.Interactions = append(c.Interactions, cassetteInteraction{
+		Request: cassetteRequest{
+			Method: r.Method,
+			URL:    c.scrub(r.URL.String()),
+			Body:   c.scrub(string(RedactJSON(requestBody))),
+		},
+		Response: cassetteResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       c.scrub(string(RedactJSON(responseBody))),
+		},
+	})
+
+	// the Cassette is written after every request since there's no hook for when the tests complete
+	if err := c.save(); err != nil {
+		log.Printf("[WARN] Unable to save Cassette %q: %+v", c.path, err)
+	}
+}
+
+func (c *cassette) save() error {
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(c.path, contents, 0644)
+}
+
+// Do replays the first unused interaction recorded for the same HTTP Method and URL
+func (c *cassette) Do(r *http.Request) (*http.Response, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	url := c.scrub(r.URL.String())
+	for i, interaction := range c.Interactions {
+		if c.used[i] || interaction.Request.Method != r.Method || interaction.Request.URL != url {
+			continue
+		}
+
+		c.used[i] = true
+
+		resp := &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{},
+			Body:          ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       r,
+		}
+		for k, v := range interaction.Response.Headers {
+			resp.Header.Set(k, v)
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("No unused interaction was recorded for %s %s in Cassette %q", r.Method, url, c.path)
+}
+
+func withRecording(c *cassette) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			var requestBody []byte
+			if r.Body != nil {
+				body, err := ioutil.ReadAll(r.Body)
+				if err != nil {
+					return nil, err
+				}
+				r.Body.Close()
+
+				requestBody = body
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+
+			resp, err := s.Do(r)
+			if err != nil || resp == nil {
+				return resp, err
+			}
+
+			var responseBody []byte
+			if resp.Body != nil {
+				body, err := ioutil.ReadAll(resp.Body)
+				if err != nil {
+					return resp, err
+				}
+				resp.Body.Close()
+
+				responseBody = body
+				resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+
+			c.record(r, requestBody, resp, responseBody)
+			return resp, nil
+		})
+	}
+}
+
+// replayAuthorizer is used in place of the configured credentials when replaying a Cassette,
+// since requests are never sent to Azure
+type replayAuthorizer struct{}
+
+func (replayAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return autorest.WithBearerAuthorization("replay")
+}
+
+// AuthorizerForRecordingMode returns the Authorizer which should be used for the current Recording Mode
+func AuthorizerForRecordingMode(auth autorest.Authorizer) autorest.Authorizer {
+	if CurrentRecordingMode() == RecordingModeReplay {
+		return replayAuthorizer{}
+	}
+
+	return auth
+}
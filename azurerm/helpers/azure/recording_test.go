@@ -0,0 +1,101 @@
+package azure
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "recordings", "TestCassette.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", "https://example.com/operations/123456789")
+		w.Header().Set("X-Ms-Request-Id", "abc")
+
+		switch r.URL.Path {
+		case "/resourceGroups/acctestRG-123456789":
+			w.Write([]byte(`{"name":"acctestRG-123456789"}`)) // nolint: errcheck
+		case "/storageAccounts/acctestsa/listKeys":
+			w.Write([]byte(`{"keys":[{"keyName":"key1","value":"super-secret","permissions":"Full"}]}`)) // nolint: errcheck
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	// record
+	recorder, err := loadCassette(path, RecordingModeRecord)
+	if err != nil {
+		t.Fatalf("Error loading Cassette: %+v", err)
+	}
+	recorder.nextPlaceholder("123456789")
+
+	sender := withRecording(recorder)(http.DefaultClient)
+	for _, path := range []string{"/resourceGroups/acctestRG-123456789", "/storageAccounts/acctestsa/listKeys"} {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+path, strings.NewReader(`{"properties":{"adminPassword":"P@ssword1234!"}}`))
+		resp, err := sender.Do(req)
+		if err != nil {
+			t.Fatalf("Error sending request: %+v", err)
+		}
+
+		// the response should be readable after being recorded
+		body, _ := ioutil.ReadAll(resp.Body)
+		if len(body) == 0 {
+			t.Fatalf("Expected the recorded response to have a body")
+		}
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error reading Cassette: %+v", err)
+	}
+	for _, unexpected := range []string{"123456789", "super-secret", "P@ssword1234!", "X-Ms-Request-Id"} {
+		if strings.Contains(string(contents), unexpected) {
+			t.Fatalf("Expected %q to be scrubbed from the Cassette but got: %s", unexpected, string(contents))
+		}
+	}
+
+	// replay
+	replayer, err := loadCassette(path, RecordingModeReplay)
+	if err != nil {
+		t.Fatalf("Error loading Cassette: %+v", err)
+	}
+	placeholder := replayer.nextPlaceholder("987654321")
+	if placeholder != placeholderRandomIntBase+1 {
+		t.Fatalf("Expected the placeholder to be %d but got %d", placeholderRandomIntBase+1, placeholder)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/resourceGroups/acctestRG-100000000000000001", nil)
+	resp, err := replayer.Do(req)
+	if err != nil {
+		t.Fatalf("Error replaying request: %+v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if string(body) != `{"name":"acctestRG-100000000000000001"}` {
+		t.Fatalf("Expected the recorded body but got %q", string(body))
+	}
+	if resp.Header.Get("Location") != "https://example.com/operations/100000000000000001" {
+		t.Fatalf("Expected the recorded Location header but got %q", resp.Header.Get("Location"))
+	}
+
+	// each interaction is only replayed once
+	if _, err := replayer.Do(req); err == nil {
+		t.Fatalf("Expected an error replaying an interaction which had already been used")
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, server.URL+"/resourceGroups/other", nil)
+	if _, err := replayer.Do(req); err == nil {
+		t.Fatalf("Expected an error replaying an interaction which wasn't recorded")
+	}
+}
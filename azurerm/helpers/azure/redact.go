@@ -0,0 +1,105 @@
+package azure
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const redactedValue = "REDACTED"
+
+// sensitiveFieldNames are the (lower-cased) names of JSON fields in requests to/responses from
+// Azure Resource Manager which contain secrets
+var sensitiveFieldNames = map[string]struct{}{
+	"accesskey":                  {},
+	"accountkey":                 {},
+	"adminpassword":              {},
+	"administratorloginpassword": {},
+	"authorizationkey":           {},
+	"clientsecret":               {},
+	"connectionstring":           {},
+	"customdata":                 {},
+	"primaryaccesskey":           {},
+	"primaryconnectionstring":    {},
+	"primarykey":                 {},
+	"primarymasterkey":           {},
+	"primaryreadonlymasterkey":   {},
+	"primarysharedkey":           {},
+	"sastoken":                   {},
+	"secondaryaccesskey":         {},
+	"secondaryconnectionstring":  {},
+	"secondarykey":               {},
+	"secondarymasterkey":         {},
+	"secondaryreadonlymasterkey": {},
+	"secondarysharedkey":         {},
+	"sharedkey":                  {},
+	"storageaccountaccesskey":    {},
+	"storageaccountkey":          {},
+}
+
+// RedactJSON returns a copy of the JSON document with the values of fields known to contain
+// secrets replaced - if the body isn't a JSON document it's returned as-is
+func RedactJSON(body []byte) []byte {
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return body
+	}
+
+	if !redactValue(doc) {
+		return body
+	}
+
+	redacted, err := json.Marshal(doc)
+	if err != nil {
+		return body
+	}
+
+	return redacted
+}
+
+// redactValue redacts any sensitive fields within the value in-place, returning whether anything was redacted
+func redactValue(input interface{}) bool {
+	redacted := false
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		// the `listKeys` API's return the keys as a list of `keyName`/`value` pairs
+		_, isKey := v["keyName"]
+
+		// Key Vault returns the value of a Secret alongside it's ID
+		if id, ok := v["id"].(string); ok && strings.Contains(strings.ToLower(id), "/secrets/") {
+			isKey = true
+		}
+
+		for key, value := range v {
+			if isSensitiveField(key) || (isKey && strings.EqualFold(key, "value")) {
+				if _, ok := value.(string); ok {
+					v[key] = redactedValue
+					redacted = true
+					continue
+				}
+			}
+
+			if redactValue(value) {
+				redacted = true
+			}
+		}
+
+	case []interface{}:
+		for _, value := range v {
+			if redactValue(value) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	if _, ok := sensitiveFieldNames[name]; ok {
+		return true
+	}
+
+	return strings.Contains(name, "password") || strings.Contains(name, "secret")
+}
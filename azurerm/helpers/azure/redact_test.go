@@ -0,0 +1,46 @@
+package azure
+
+import "testing"
+
+func TestRedactJSON(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    `not json`,
+			expected: `not json`,
+		},
+		{
+			input:    `{"name":"example","location":"westus"}`,
+			expected: `{"name":"example","location":"westus"}`,
+		},
+		{
+			input:    `{"properties":{"administratorLogin":"admin","administratorLoginPassword":"P@ssword1234!"}}`,
+			expected: `{"properties":{"administratorLogin":"admin","administratorLoginPassword":"REDACTED"}}`,
+		},
+		{
+			input:    `{"keys":[{"keyName":"key1","permissions":"Full","value":"abc123"}]}`,
+			expected: `{"keys":[{"keyName":"key1","permissions":"Full","value":"REDACTED"}]}`,
+		},
+		{
+			input:    `{"primaryConnectionString":"Endpoint=sb://example","secondaryKey":"abc"}`,
+			expected: `{"primaryConnectionString":"REDACTED","secondaryKey":"REDACTED"}`,
+		},
+		{
+			input:    `{"id":"https://example.vault.azure.net/secrets/example/abc","value":"super-secret"}`,
+			expected: `{"id":"https://example.vault.azure.net/secrets/example/abc","value":"REDACTED"}`,
+		},
+		{
+			input:    `{"passwordCredentials":[{"customKeyIdentifier":null}],"value":"not-a-key"}`,
+			expected: `{"passwordCredentials":[{"customKeyIdentifier":null}],"value":"not-a-key"}`,
+		},
+	}
+
+	for _, v := range cases {
+		actual := string(RedactJSON([]byte(v.input)))
+		if v.expected != actual {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	clientRequestIdHeader       = "x-ms-client-request-id"
+	correlationRequestIdHeader  = "x-ms-correlation-request-id"
+	returnClientRequestIdHeader = "x-ms-return-client-request-id"
+)
+
+type requestTrackerKey struct{}
+
+// RequestTracker captures the ID's of the requests sent to Azure during a single operation,
+// so that these can be included in any error returned - since they're needed when raising
+// a support ticket with Microsoft
+type RequestTracker struct {
+	lock sync.Mutex
+
+	clientRequestId      string
+	correlationRequestId string
+	failed               bool
+}
+
+// WithRequestTracker returns a copy of the context containing a new RequestTracker, which is
+// populated by every request sent with this context
+func WithRequestTracker(ctx context.Context) (context.Context, *RequestTracker) {
+	tracker := &RequestTracker{}
+	return context.WithValue(ctx, requestTrackerKey{}, tracker), tracker
+}
+
+func requestTrackerFromContext(ctx context.Context) *RequestTracker {
+	if ctx == nil {
+		return nil
+	}
+
+	tracker, _ := ctx.Value(requestTrackerKey{}).(*RequestTracker)
+	return tracker
+}
+
+func (t *RequestTracker) track(r *http.Request, resp *http.Response, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	failed := err != nil || resp == nil || resp.StatusCode >= http.StatusBadRequest
+
+	// the most recent failed request is the most useful when diagnosing an error
+	if t.failed && !failed {
+		return
+	}
+
+	t.failed = failed
+	t.clientRequestId = r.Header.Get(clientRequestIdHeader)
+	t.correlationRequestId = ""
+	if resp != nil {
+		t.correlationRequestId = resp.Header.Get(correlationRequestIdHeader)
+	}
+}
+
+// Annotate returns the error with the ID's of the (most recent failed) request appended
+func (t *RequestTracker) Annotate(err error) error {
+	if err == nil || t == nil {
+		return err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.clientRequestId == "" && t.correlationRequestId == "" {
+		return err
+	}
+
+	correlationRequestId := t.correlationRequestId
+	if correlationRequestId == "" {
+		correlationRequestId = "(none returned)"
+	}
+
+	return fmt.Errorf("%s\n\nAzure Request ID's: %s: %s, %s: %s", err, clientRequestIdHeader, t.clientRequestId, correlationRequestIdHeader, correlationRequestId)
+}
+
+// withRequestIds assigns a unique `x-ms-client-request-id` to each request and records the
+// request ID's in the RequestTracker for the operation, if there is one
+func withRequestIds() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if r.Header.Get(clientRequestIdHeader) == "" {
+				id, err := uuid.GenerateUUID()
+				if err != nil {
+					log.Printf("[WARN] Unable to generate an ID for the request to %s: %+v", r.URL, err)
+				} else {
+					r.Header.Set(clientRequestIdHeader, id)
+					r.Header.Set(returnClientRequestIdHeader, "true")
+				}
+			}
+
+			resp, err := s.Do(r)
+
+			if tracker := requestTrackerFromContext(r.Context()); tracker != nil {
+				tracker.track(r, resp, err)
+			}
+
+			return resp, err
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestTracker(t *testing.T) {
+	correlationIds := []string{"first", "second", "third"}
+	statusCodes := []int{http.StatusOK, http.StatusConflict, http.StatusOK}
+	clientRequestIds := make([]string, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := len(clientRequestIds)
+		clientRequestIds = append(clientRequestIds, r.Header.Get(clientRequestIdHeader))
+
+		w.Header().Set(correlationRequestIdHeader, correlationIds[i])
+		w.WriteHeader(statusCodes[i])
+	}))
+	defer server.Close()
+
+	ctx, tracker := WithRequestTracker(context.Background())
+	sender := withRequestIds()(http.DefaultClient)
+
+	for range correlationIds {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		if _, err := sender.Do(req.WithContext(ctx)); err != nil {
+			t.Fatalf("Error sending request: %+v", err)
+		}
+	}
+
+	if clientRequestIds[0] == "" || clientRequestIds[0] == clientRequestIds[1] {
+		t.Fatalf("Expected each request to have a unique Client Request ID but got %+v", clientRequestIds)
+	}
+
+	// the failed request should be reported, rather than the most recent
+	err := tracker.Annotate(fmt.Errorf("Error creating Resource Group"))
+	expected := fmt.Sprintf("%s: %s, %s: %s", clientRequestIdHeader, clientRequestIds[1], correlationRequestIdHeader, "second")
+	if !strings.HasPrefix(err.Error(), "Error creating Resource Group") || !strings.HasSuffix(err.Error(), expected) {
+		t.Fatalf("Expected the error to include %q but got %q", expected, err.Error())
+	}
+
+	if tracker.Annotate(nil) != nil {
+		t.Fatalf("Expected no error to be returned when there's no error")
+	}
+}
+
+func TestRequestTracker_NoRequests(t *testing.T) {
+	_, tracker := WithRequestTracker(context.Background())
+
+	err := tracker.Annotate(fmt.Errorf("Error parsing ID"))
+	if err.Error() != "Error parsing ID" {
+		t.Fatalf("Expected the error to be unchanged but got %q", err.Error())
+	}
+}
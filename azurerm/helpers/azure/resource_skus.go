@@ -0,0 +1,142 @@
+package azure
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+)
+
+// FindResourceSku returns the SKU with the specified Resource Type (e.g. `virtualMachines`) and Name (e.g. `Standard_F2`)
+// which is offered in the specified Location - or nil if no such SKU exists. The Resource SKUs API returns a separate
+// entry for each Location a SKU is offered in, so all three need to match. The Resource Type and Name are compared
+// case-insensitively.
+func FindResourceSku(skus []compute.ResourceSku, resourceType string, name string, location string) *compute.ResourceSku {
+	for i, sku := range skus {
+		if !resourceSkuMatches(sku, resourceType, name) {
+			continue
+		}
+
+		if ResourceSkuSupportsLocation(sku, location) {
+			return &skus[i]
+		}
+	}
+
+	return nil
+}
+
+func resourceSkuMatches(sku compute.ResourceSku, resourceType string, name string) bool {
+	if sku.ResourceType == nil || sku.Name == nil {
+		return false
+	}
+
+	return strings.EqualFold(*sku.ResourceType, resourceType) && strings.EqualFold(*sku.Name, name)
+}
+
+// ResourceSkuSupportsLocation returns whether the SKU is offered in the specified Location
+func ResourceSkuSupportsLocation(sku compute.ResourceSku, location string) bool {
+	if sku.Locations == nil {
+		return false
+	}
+
+	return containsLocation(*sku.Locations, location)
+}
+
+// ResourceSkuRestrictedInLocation returns whether the SKU is offered in the specified Location but can't be
+// used by this Subscription, along with the reason for the restriction
+func ResourceSkuRestrictedInLocation(sku compute.ResourceSku, location string) (bool, string) {
+	if sku.Restrictions == nil {
+		return false, ""
+	}
+
+	for _, restriction := range *sku.Restrictions {
+		if restriction.Type != compute.Location || restriction.Values == nil {
+			continue
+		}
+
+		if containsLocation(*restriction.Values, location) {
+			return true, string(restriction.ReasonCode)
+		}
+	}
+
+	return false, ""
+}
+
+// ResourceSkuAvailableZones returns the Availability Zones in the specified Location in which the SKU
+// can be used by this Subscription, taking any Zone Restrictions into account
+func ResourceSkuAvailableZones(sku compute.ResourceSku, location string) []string {
+	zones := make([]string, 0)
+	if sku.LocationInfo == nil {
+		return zones
+	}
+
+	restrictedZones := make(map[string]struct{})
+	if sku.Restrictions != nil {
+		for _, restriction := range *sku.Restrictions {
+			if restriction.Type != compute.Zone || restriction.RestrictionInfo == nil {
+				continue
+			}
+
+			info := restriction.RestrictionInfo
+			if info.Locations != nil && !containsLocation(*info.Locations, location) {
+				continue
+			}
+
+			if info.Zones != nil {
+				for _, zone := range *info.Zones {
+					restrictedZones[zone] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for _, info := range *sku.LocationInfo {
+		if info.Location == nil || NormalizeLocation(*info.Location) != NormalizeLocation(location) || info.Zones == nil {
+			continue
+		}
+
+		for _, zone := range *info.Zones {
+			if _, restricted := restrictedZones[zone]; !restricted {
+				zones = append(zones, zone)
+			}
+		}
+	}
+
+	sort.Strings(zones)
+	return zones
+}
+
+// ValidateResourceSkuAvailability validates that the SKU can be used in the specified Location and Zones
+func ValidateResourceSkuAvailability(skus []compute.ResourceSku, resourceType string, name string, location string, zones []string) error {
+	sku := FindResourceSku(skus, resourceType, name, location)
+	if sku == nil {
+		for _, v := range skus {
+			if resourceSkuMatches(v, resourceType, name) {
+				return fmt.Errorf("The SKU %q (Resource Type %q) is not available in the location %q", name, resourceType, location)
+			}
+		}
+
+		return fmt.Errorf("The SKU %q was not found for the Resource Type %q", name, resourceType)
+	}
+
+	if restricted, reason := ResourceSkuRestrictedInLocation(*sku, location); restricted {
+		return fmt.Errorf("The SKU %q (Resource Type %q) is restricted in the location %q for this Subscription (Reason %q)", name, resourceType, location, reason)
+	}
+
+	if err := ValidateZonesAvailable(zones, ResourceSkuAvailableZones(*sku, location)); err != nil {
+		return fmt.Errorf("The SKU %q (Resource Type %q) cannot be used in the location %q: %+v", name, resourceType, location, err)
+	}
+
+	return nil
+}
+
+func containsLocation(locations []string, location string) bool {
+	for _, v := range locations {
+		if NormalizeLocation(v) == NormalizeLocation(location) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,216 @@
+package azure
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+)
+
+func testResourceSkus() []compute.ResourceSku {
+	str := func(v string) *string { return &v }
+	return []compute.ResourceSku{
+		{
+			ResourceType: str("disks"),
+			Name:         str("Standard_LRS"),
+			Locations:    &[]string{"westeurope"},
+		},
+		{
+			ResourceType: str("virtualMachines"),
+			Name:         str("Standard_F2"),
+			Locations:    &[]string{"westeurope"},
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{
+				{
+					Location: str("westeurope"),
+					Zones:    &[]string{"3", "1", "2"},
+				},
+			},
+			Restrictions: &[]compute.ResourceSkuRestrictions{
+				{
+					Type:   compute.Zone,
+					Values: &[]string{"westeurope"},
+					RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+						Locations: &[]string{"westeurope"},
+						Zones:     &[]string{"2"},
+					},
+					ReasonCode: compute.NotAvailableForSubscription,
+				},
+			},
+		},
+		{
+			// each location a SKU is offered in is returned as a separate entry
+			ResourceType: str("virtualMachines"),
+			Name:         str("Standard_F2"),
+			Locations:    &[]string{"northeurope"},
+			LocationInfo: &[]compute.ResourceSkuLocationInfo{
+				{
+					Location: str("northeurope"),
+					Zones:    &[]string{"1", "2", "3"},
+				},
+			},
+		},
+		{
+			ResourceType: str("virtualMachines"),
+			Name:         str("Standard_M128s"),
+			Locations:    &[]string{"eastus"},
+			Restrictions: &[]compute.ResourceSkuRestrictions{
+				{
+					Type:       compute.Location,
+					Values:     &[]string{"eastus"},
+					ReasonCode: compute.NotAvailableForSubscription,
+				},
+			},
+		},
+	}
+}
+
+func TestFindResourceSku(t *testing.T) {
+	skus := testResourceSkus()
+
+	if sku := FindResourceSku(skus, "VirtualMachines", "standard_f2", "West Europe"); sku == nil || *sku.Name != "Standard_F2" || (*sku.Locations)[0] != "westeurope" {
+		t.Fatalf("Expected the SKU `Standard_F2` in `westeurope` to be found but got %+v", sku)
+	}
+
+	if sku := FindResourceSku(skus, "virtualMachines", "Standard_F2", "northeurope"); sku == nil || (*sku.Locations)[0] != "northeurope" {
+		t.Fatalf("Expected the SKU `Standard_F2` in `northeurope` to be found but got %+v", sku)
+	}
+
+	if sku := FindResourceSku(skus, "virtualMachines", "Standard_F2", "eastus"); sku != nil {
+		t.Fatalf("Expected no SKU to be found in `eastus` but got %+v", sku)
+	}
+
+	if sku := FindResourceSku(skus, "disks", "Standard_F2", "westeurope"); sku != nil {
+		t.Fatalf("Expected no SKU to be found but got %+v", sku)
+	}
+}
+
+func TestResourceSkuAvailableZones(t *testing.T) {
+	sku := *FindResourceSku(testResourceSkus(), "virtualMachines", "Standard_F2", "westeurope")
+
+	cases := map[string][]string{
+		"West Europe": {"1", "3"},
+		"westeurope":  {"1", "3"},
+		"eastus":      {},
+	}
+
+	for location, expected := range cases {
+		if actual := ResourceSkuAvailableZones(sku, location); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Expected the available zones in %q to be %+v but got %+v", location, expected, actual)
+		}
+	}
+}
+
+func TestValidateResourceSkuAvailability(t *testing.T) {
+	cases := []struct {
+		ResourceType string
+		Name         string
+		Location     string
+		Zones        []string
+		ShouldError  bool
+	}{
+		{
+			ResourceType: "virtualMachines",
+			Name:         "Standard_F2",
+			Location:     "West Europe",
+			Zones:        []string{},
+			ShouldError:  false,
+		},
+		{
+			ResourceType: "virtualMachines",
+			Name:         "Standard_F2",
+			Location:     "westeurope",
+			Zones:        []string{"1"},
+			ShouldError:  false,
+		},
+		{
+			// restricted for this subscription
+			ResourceType: "virtualMachines",
+			Name:         "Standard_F2",
+			Location:     "westeurope",
+			Zones:        []string{"2"},
+			ShouldError:  true,
+		},
+		{
+			// offered in a second location, which is a separate entry without the zone restriction
+			ResourceType: "virtualMachines",
+			Name:         "Standard_F2",
+			Location:     "North Europe",
+			Zones:        []string{"2"},
+			ShouldError:  false,
+		},
+		{
+			// not offered in this location
+			ResourceType: "virtualMachines",
+			Name:         "Standard_F2",
+			Location:     "eastus",
+			Zones:        []string{},
+			ShouldError:  true,
+		},
+		{
+			ResourceType: "virtualMachines",
+			Name:         "Standard_M128s",
+			Location:     "eastus",
+			Zones:        []string{},
+			ShouldError:  true,
+		},
+		{
+			// disks without zonal information don't support zones
+			ResourceType: "disks",
+			Name:         "Standard_LRS",
+			Location:     "westeurope",
+			Zones:        []string{"1"},
+			ShouldError:  true,
+		},
+		{
+			ResourceType: "virtualMachines",
+			Name:         "Standard_Unknown",
+			Location:     "westeurope",
+			Zones:        []string{},
+			ShouldError:  true,
+		},
+	}
+
+	skus := testResourceSkus()
+	for _, tc := range cases {
+		err := ValidateResourceSkuAvailability(skus, tc.ResourceType, tc.Name, tc.Location, tc.Zones)
+		if (err != nil) != tc.ShouldError {
+			t.Fatalf("Expected %q in %q (Zones %+v) to error: %t but got %+v", tc.Name, tc.Location, tc.Zones, tc.ShouldError, err)
+		}
+	}
+}
+
+func TestValidateZonesAvailable(t *testing.T) {
+	cases := []struct {
+		Zones          []string
+		AvailableZones []string
+		ShouldError    bool
+	}{
+		{
+			Zones:          []string{},
+			AvailableZones: []string{},
+			ShouldError:    false,
+		},
+		{
+			Zones:          []string{"1", "3"},
+			AvailableZones: []string{"1", "2", "3"},
+			ShouldError:    false,
+		},
+		{
+			Zones:          []string{"4"},
+			AvailableZones: []string{"1", "2", "3"},
+			ShouldError:    true,
+		},
+		{
+			Zones:          []string{"1"},
+			AvailableZones: []string{},
+			ShouldError:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := ValidateZonesAvailable(tc.Zones, tc.AvailableZones)
+		if (err != nil) != tc.ShouldError {
+			t.Fatalf("Expected Zones %+v (Available %+v) to error: %t but got %+v", tc.Zones, tc.AvailableZones, tc.ShouldError, err)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package azure
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RetryPolicy defines how requests to Azure Resource Manager which are throttled
+// or fail with a transient error should be retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times a request will be sent (including the first attempt)
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry, which doubles on each subsequent retry
+	MinBackoff time.Duration
+
+	// MaxBackoff is the upper bound of the delay between two attempts
+	MaxBackoff time.Duration
+
+	// StatusCodes are the HTTP Status Codes which should be retried
+	StatusCodes []int
+}
+
+// DefaultRetryPolicy returns the Retry Policy used when none is configured in the Provider block
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  2 * time.Second,
+		MaxBackoff:  60 * time.Second,
+		StatusCodes: DefaultRetryStatusCodes(),
+	}
+}
+
+// DefaultRetryStatusCodes returns the HTTP Status Codes which are retried by default
+func DefaultRetryStatusCodes() []int {
+	return []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+}
+
+func (p RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// requests which aren't idempotent (e.g. a POST to restart a Virtual Machine or regenerate a key) may
+	// have been accepted by the API even though they failed - so these are only retried when Azure
+	// explicitly tells us it hasn't processed the request
+	idempotent := isIdempotentMethod(req.Method)
+
+	if err != nil {
+		return idempotent && isTransientNetworkError(err)
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	if !idempotent {
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests:
+		case http.StatusServiceUnavailable:
+			if resp.Header.Get("Retry-After") == "" {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	for _, code := range p.StatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// delayFor returns how long to wait before sending the next attempt - honouring the `Retry-After`
+// header when the API returns one, otherwise backing off exponentially (with jitter)
+func (p RetryPolicy) delayFor(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay
+		}
+	}
+
+	delay := p.MinBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	// spread the retries out, so that parallel requests which were throttled together don't retry together
+	if half := int64(delay / 2); half > 0 {
+		delay = time.Duration(half + rand.Int63n(half+1))
+	}
+
+	return delay
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		delay := time.Until(at)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func isTransientNetworkError(err error) bool {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+
+	if netErr, ok := err.(net.Error); ok && (netErr.Timeout() || netErr.Temporary()) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "connection reset by peer") || strings.Contains(msg, "broken pipe")
+}
+
+func withRetries(policy RetryPolicy) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if policy.MaxAttempts <= 1 {
+				return s.Do(r)
+			}
+
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+
+				resp, err := s.Do(rr.Request())
+				if attempt >= policy.MaxAttempts || r.Context().Err() != nil || !policy.shouldRetry(r, resp, err) {
+					return resp, err
+				}
+
+				delay := policy.delayFor(resp, attempt)
+				if resp != nil {
+					log.Printf("[DEBUG] AzureRM Request to %s returned %s (attempt %d of %d) - retrying in %s", r.URL, resp.Status, attempt, policy.MaxAttempts, delay)
+
+					// the response is discarded, so drain the body to allow the connection to be re-used
+					if resp.Body != nil {
+						io.Copy(ioutil.Discard, resp.Body) // nolint: errcheck
+						resp.Body.Close()
+					}
+				} else {
+					log.Printf("[DEBUG] AzureRM Request to %s failed (attempt %d of %d): %+v - retrying in %s", r.URL, attempt, policy.MaxAttempts, err, delay)
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
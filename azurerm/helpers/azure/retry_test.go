@@ -0,0 +1,261 @@
+package azure
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Retries(t *testing.T) {
+	cases := []struct {
+		name             string
+		responses        []int
+		maxAttempts      int
+		expectedStatus   int
+		expectedAttempts int
+	}{
+		{
+			name:             "success",
+			responses:        []int{http.StatusOK},
+			maxAttempts:      3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "throttled then success",
+			responses:        []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			maxAttempts:      3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "transient server error then success",
+			responses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxAttempts:      3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "attempts exhausted",
+			responses:        []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			maxAttempts:      2,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "not retryable",
+			responses:        []int{http.StatusBadRequest, http.StatusOK},
+			maxAttempts:      3,
+			expectedStatus:   http.StatusBadRequest,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "retries disabled",
+			responses:        []int{http.StatusTooManyRequests, http.StatusOK},
+			maxAttempts:      1,
+			expectedStatus:   http.StatusTooManyRequests,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				if string(body) != "payload" {
+					t.Errorf("Expected the request body to be re-sent but got %q", string(body))
+				}
+
+				w.WriteHeader(v.responses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			policy := RetryPolicy{
+				MaxAttempts: v.maxAttempts,
+				MinBackoff:  time.Millisecond,
+				MaxBackoff:  5 * time.Millisecond,
+				StatusCodes: DefaultRetryStatusCodes(),
+			}
+			sender := withRetries(policy)(http.DefaultClient)
+
+			req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("payload"))
+			resp, err := sender.Do(req)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if resp.StatusCode != v.expectedStatus {
+				t.Fatalf("Expected the status code to be %d but got %d", v.expectedStatus, resp.StatusCode)
+			}
+
+			if attempts != v.expectedAttempts {
+				t.Fatalf("Expected %d attempts but got %d", v.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_NonIdempotentRequests(t *testing.T) {
+	cases := []struct {
+		name             string
+		method           string
+		status           int
+		retryAfter       string
+		expectedAttempts int
+	}{
+		{
+			name:             "post throttled",
+			method:           http.MethodPost,
+			status:           http.StatusTooManyRequests,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "post unavailable with retry-after",
+			method:           http.MethodPost,
+			status:           http.StatusServiceUnavailable,
+			retryAfter:       "0",
+			expectedAttempts: 2,
+		},
+		{
+			name:             "post unavailable without retry-after",
+			method:           http.MethodPost,
+			status:           http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "post server error",
+			method:           http.MethodPost,
+			status:           http.StatusInternalServerError,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "patch bad gateway",
+			method:           http.MethodPatch,
+			status:           http.StatusBadGateway,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "delete server error",
+			method:           http.MethodDelete,
+			status:           http.StatusInternalServerError,
+			expectedAttempts: 2,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if attempts > 1 {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+
+				if v.retryAfter != "" {
+					w.Header().Set("Retry-After", v.retryAfter)
+				}
+				w.WriteHeader(v.status)
+			}))
+			defer server.Close()
+
+			policy := RetryPolicy{
+				MaxAttempts: 3,
+				MinBackoff:  time.Millisecond,
+				MaxBackoff:  5 * time.Millisecond,
+				StatusCodes: DefaultRetryStatusCodes(),
+			}
+			sender := withRetries(policy)(http.DefaultClient)
+
+			req, _ := http.NewRequest(v.method, server.URL, strings.NewReader("payload"))
+			if _, err := sender.Do(req); err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if attempts != v.expectedAttempts {
+				t.Fatalf("Expected %d attempts but got %d", v.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_NetworkErrors(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	err := errors.New("read tcp 10.0.0.1:1234->10.0.0.2:443: read: connection reset by peer")
+
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+		req, _ := http.NewRequest(method, "https://management.azure.com", nil)
+		if !policy.shouldRetry(req, nil, err) {
+			t.Fatalf("Expected a %s request which failed with a connection reset to be retried", method)
+		}
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPatch} {
+		req, _ := http.NewRequest(method, "https://management.azure.com", nil)
+		if policy.shouldRetry(req, nil, err) {
+			t.Fatalf("Expected a %s request which failed with a connection reset not to be retried", method)
+		}
+	}
+}
+
+func TestRetryPolicy_DelayFor(t *testing.T) {
+	policy := RetryPolicy{
+		MinBackoff: 2 * time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+
+	cases := []struct {
+		attempt    int
+		retryAfter string
+		min        time.Duration
+		max        time.Duration
+	}{
+		{
+			attempt: 1,
+			min:     time.Second,
+			max:     2 * time.Second,
+		},
+		{
+			attempt: 2,
+			min:     2 * time.Second,
+			max:     4 * time.Second,
+		},
+		{
+			attempt: 10,
+			min:     5 * time.Second,
+			max:     10 * time.Second,
+		},
+		{
+			attempt:    1,
+			retryAfter: "30",
+			min:        30 * time.Second,
+			max:        30 * time.Second,
+		},
+		{
+			attempt:    1,
+			retryAfter: "not-a-delay",
+			min:        time.Second,
+			max:        2 * time.Second,
+		},
+	}
+
+	for _, v := range cases {
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		if v.retryAfter != "" {
+			resp.Header.Set("Retry-After", v.retryAfter)
+		}
+
+		actual := policy.delayFor(resp, v.attempt)
+		if actual < v.min || actual > v.max {
+			t.Fatalf("Expected the delay for attempt %d (Retry-After %q) to be between %s and %s but got %s", v.attempt, v.retryAfter, v.min, v.max, actual)
+		}
+	}
+}
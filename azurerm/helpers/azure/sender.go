@@ -1,57 +1,35 @@
 package azure
 
 import (
-	"log"
 	"net/http"
-	"net/http/httputil"
 
 	"github.com/Azure/go-autorest/autorest"
 )
 
-func BuildSender() autorest.Sender {
-	return autorest.DecorateSender(&http.Client{
+func BuildSender(retryPolicy RetryPolicy) autorest.Sender {
+	client := &http.Client{
 		Transport: &http.Transport{
 			Proxy: http.ProxyFromEnvironment,
 		},
-	}, withRequestLogging())
-}
-
-func withRequestLogging() autorest.SendDecorator {
-	return func(s autorest.Sender) autorest.Sender {
-		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
-			// strip the authorization header prior to printing
-			authHeaderName := "Authorization"
-			auth := r.Header.Get(authHeaderName)
-			if auth != "" {
-				r.Header.Del(authHeaderName)
-			}
-
-			// dump request to wire format
-			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
-				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
-			} else {
-				// fallback to basic message
-				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
-			}
+	}
 
-			// add the auth header back
-			if auth != "" {
-				r.Header.Add(authHeaderName, auth)
-			}
+	mode := CurrentRecordingMode()
+	if mode == RecordingModeDisabled {
+		// NOTE: decorators are applied inside-out, so each retry is logged individually
+		return autorest.DecorateSender(client, withRequestLogging(), withRequestIds(), withRetries(retryPolicy))
+	}
 
-			resp, err := s.Do(r)
-			if resp != nil {
-				// dump response to wire format
-				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
-				} else {
-					// fallback to basic message
-					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
-				}
-			} else {
-				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
-			}
-			return resp, err
+	c, err := activeCassette()
+	if err != nil {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, err
 		})
 	}
+
+	if mode == RecordingModeReplay {
+		return autorest.DecorateSender(c, withRequestLogging(), withRequestIds())
+	}
+
+	// only the final response is recorded, so that retries don't need to be replayed
+	return autorest.DecorateSender(client, withRequestLogging(), withRequestIds(), withRetries(retryPolicy), withRecording(c))
 }
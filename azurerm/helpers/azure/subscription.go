@@ -2,6 +2,8 @@ package azure
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
 func SchemaSubscription(subscriptionIDOptional bool) map[string]*schema.Schema {
@@ -40,3 +42,16 @@ func SchemaSubscription(subscriptionIDOptional bool) map[string]*schema.Schema {
 
 	return s
 }
+
+// SchemaSubscriptionIdOptionalForceNew returns the schema for the `subscription_id` field used to
+// target a Subscription other than the one configured in the Provider block
+func SchemaSubscriptionIdOptionalForceNew() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ForceNew:         true,
+		ValidateFunc:     validate.UUID,
+		DiffSuppressFunc: suppress.CaseDifference,
+	}
+}
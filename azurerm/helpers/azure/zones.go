@@ -1,6 +1,11 @@
 package azure
 
-import "github.com/hashicorp/terraform/helper/schema"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
 
 func SchemaZones() *schema.Schema {
 	return &schema.Schema{
@@ -47,3 +52,31 @@ func ExpandZones(v []interface{}) *[]string {
 		return nil
 	}
 }
+
+// ValidateZonesAvailable validates that each of the specified Availability Zones is one of the available Zones
+func ValidateZonesAvailable(zones []string, availableZones []string) error {
+	unavailable := make([]string, 0)
+	for _, zone := range zones {
+		found := false
+		for _, v := range availableZones {
+			if v == zone {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			unavailable = append(unavailable, zone)
+		}
+	}
+
+	if len(unavailable) == 0 {
+		return nil
+	}
+
+	if len(availableZones) == 0 {
+		return fmt.Errorf("the Availability Zones %q are not available since this location has no available Availability Zones", strings.Join(unavailable, ", "))
+	}
+
+	return fmt.Errorf("the Availability Zones %q are not available - possible values are %q", strings.Join(unavailable, ", "), strings.Join(availableZones, ", "))
+}
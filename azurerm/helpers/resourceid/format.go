@@ -0,0 +1,122 @@
+package resourceid
+
+//go:generate go run ./generator -output ids.go
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// segment is a single key/value pair within a Resource ID - where the value is either fixed (for example
+// the Resource Provider namespace) or is parsed from the ID
+type segment struct {
+	key        string
+	fixedValue string
+}
+
+// idFormat describes the structure of a Resource ID for a single type of resource
+type idFormat struct {
+	description string
+	segments    []segment
+}
+
+// parse returns the values of the segments which aren't fixed (in order) - the keys and fixed values of
+// each segment are matched case-insensitively, since Azure doesn't consistently return these in the same case
+func (f idFormat) parse(input string) ([]string, error) {
+	if input == "" {
+		return nil, fmt.Errorf("The %s ID was empty", f.description)
+	}
+
+	components := strings.Split(strings.TrimSuffix(strings.TrimPrefix(input, "/"), "/"), "/")
+	if len(components) != len(f.segments)*2 {
+		return nil, fmt.Errorf("Expected the %s ID to be in the format %q but got %q", f.description, f.template(), input)
+	}
+
+	values := make([]string, 0)
+	for i, s := range f.segments {
+		key := components[i*2]
+		value := components[i*2+1]
+
+		if !strings.EqualFold(key, s.key) {
+			return nil, fmt.Errorf("Expected segment %d of the %s ID to be %q but got %q in %q", i+1, f.description, s.key, key, input)
+		}
+
+		if value == "" {
+			return nil, fmt.Errorf("The value for the segment %q of the %s ID was empty in %q", s.key, f.description, input)
+		}
+
+		if s.fixedValue != "" {
+			if !strings.EqualFold(value, s.fixedValue) {
+				return nil, fmt.Errorf("Expected the segment %q of the %s ID to be %q but got %q in %q", s.key, f.description, s.fixedValue, value, input)
+			}
+
+			continue
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// format returns the Resource ID containing the specified values for the segments which aren't fixed
+func (f idFormat) format(values ...string) string {
+	components := make([]string, 0)
+
+	i := 0
+	for _, s := range f.segments {
+		value := s.fixedValue
+		if value == "" {
+			value = values[i]
+			i++
+		}
+
+		components = append(components, s.key, value)
+	}
+
+	return "/" + strings.Join(components, "/")
+}
+
+// template returns the format of the Resource ID, for use in error messages
+func (f idFormat) template() string {
+	components := make([]string, 0)
+	for _, s := range f.segments {
+		value := s.fixedValue
+		if value == "" {
+			value = "{" + s.key + "}"
+		}
+
+		components = append(components, s.key, value)
+	}
+
+	return "/" + strings.Join(components, "/")
+}
+
+// validate is a SchemaValidateFunc which ensures the value is a Resource ID in the specified format
+func (f idFormat) validate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if _, err := f.parse(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a resource id: %v", k, err))
+	}
+
+	return warnings, errors
+}
+
+// OrEmpty wraps one of the Validate functions for an ID so that an empty value is also accepted, for use on
+// Optional fields which are sent to Azure as an empty string to remove the association
+func OrEmpty(validateFunc schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		if v, ok := i.(string); ok && v == "" {
+			return warnings, errors
+		}
+
+		return validateFunc(i, k)
+	}
+}
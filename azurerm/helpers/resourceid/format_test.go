@@ -0,0 +1,151 @@
+package resourceid
+
+import (
+	"testing"
+)
+
+func TestParseSubnetId(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected *SubnetId
+	}{
+		{
+			input: "",
+		},
+		{
+			// the wrong resource type
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		},
+		{
+			// the wrong resource provider
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			// a child resource
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1/ipConfigurations/config1",
+		},
+		{
+			// an empty value
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			expected: &SubnetId{
+				SubscriptionId:     "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:      "group1",
+				VirtualNetworkName: "network1",
+				Name:               "subnet1",
+			},
+		},
+		{
+			// the segments should be matched case-insensitively
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/Group1/providers/microsoft.network/virtualnetworks/Network1/Subnets/Subnet1/",
+			expected: &SubnetId{
+				SubscriptionId:     "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:      "Group1",
+				VirtualNetworkName: "Network1",
+				Name:               "Subnet1",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		actual, err := ParseSubnetId(v.input)
+		if v.expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but got %+v", v.input, actual)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got %+v", v.input, err)
+		}
+
+		if *actual != *v.expected {
+			t.Fatalf("Expected %+v but got %+v", *v.expected, *actual)
+		}
+	}
+}
+
+func TestSubnetId_String(t *testing.T) {
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	actual := NewSubnetId("00000000-0000-0000-0000-000000000000", "group1", "network1", "subnet1").String()
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestResourceGroupId(t *testing.T) {
+	input := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	id, err := ParseResourceGroupId(input)
+	if err != nil {
+		t.Fatalf("Expected no error parsing %q but got %+v", input, err)
+	}
+
+	if id.SubscriptionId != "00000000-0000-0000-0000-000000000000" || id.Name != "group1" {
+		t.Fatalf("Unexpected ID parsed from %q: %+v", input, *id)
+	}
+
+	if id.String() != input {
+		t.Fatalf("Expected %q but got %q", input, id.String())
+	}
+}
+
+func TestValidateNetworkSecurityGroupId(t *testing.T) {
+	cases := []struct {
+		input interface{}
+		valid bool
+	}{
+		{
+			input: 1,
+			valid: false,
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/routeTables/table1",
+			valid: false,
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/group1",
+			valid: true,
+		},
+	}
+
+	for _, v := range cases {
+		_, errors := ValidateNetworkSecurityGroupId(v.input, "network_security_group_id")
+		if valid := len(errors) == 0; valid != v.valid {
+			t.Fatalf("Expected %+v to be valid: %t but got %+v", v.input, v.valid, errors)
+		}
+	}
+}
+
+func TestOrEmpty(t *testing.T) {
+	cases := []struct {
+		input interface{}
+		valid bool
+	}{
+		{
+			input: 1,
+			valid: false,
+		},
+		{
+			input: "",
+			valid: true,
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/routeTables/table1",
+			valid: false,
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+			valid: true,
+		},
+	}
+
+	for _, v := range cases {
+		_, errors := OrEmpty(ValidatePublicIPId)(v.input, "public_ip_address_id")
+		if valid := len(errors) == 0; valid != v.valid {
+			t.Fatalf("Expected %+v to be valid: %t but got %+v", v.input, v.valid, errors)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+// definitions are the Resource ID's which typed parsers are generated for - each segment of the format
+// whose value is wrapped in braces is parsed into a field, the others must match the format exactly
+var definitions = []definition{
+	// API Management
+	{"ApiManagement", "API Management Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{name}"},
+	{"ApiManagementApi", "API Management API", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{name}"},
+	{"ApiManagementApiOperation", "API Management API Operation", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{apiName}/operations/{name}"},
+	{"ApiManagementApiVersionSet", "API Management API Version Set", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/api-version-sets/{name}"},
+	{"ApiManagementAuthorizationServer", "API Management Authorization Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/authorizationServers/{name}"},
+	{"ApiManagementCertificate", "API Management Certificate", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/certificates/{name}"},
+	{"ApiManagementGroup", "API Management Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/groups/{name}"},
+	{"ApiManagementLogger", "API Management Logger", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/loggers/{name}"},
+	{"ApiManagementOpenIDConnectProvider", "API Management OpenID Connect Provider", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/openidConnectProviders/{name}"},
+	{"ApiManagementProduct", "API Management Product", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/products/{name}"},
+	{"ApiManagementProperty", "API Management Property", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/properties/{name}"},
+	{"ApiManagementSubscription", "API Management Subscription", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/subscriptions/{name}"},
+	{"ApiManagementUser", "API Management User", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/users/{name}"},
+
+	// App Service
+	{"AppService", "App Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{name}"},
+	{"AppServiceCustomHostnameBinding", "App Service Custom Hostname Binding", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{appServiceName}/hostNameBindings/{name}"},
+	{"AppServicePlan", "App Service Plan", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/serverfarms/{name}"},
+	{"AppServiceSlot", "App Service Slot", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{appServiceName}/slots/{name}"},
+
+	// Application Insights
+	{"ApplicationInsights", "Application Insights", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{name}"},
+	{"ApplicationInsightsApiKey", "Application Insights API Key", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{componentName}/apiKeys/{name}"},
+
+	// Automation
+	{"AutomationAccount", "Automation Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{name}"},
+	{"AutomationCredential", "Automation Credential", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/credentials/{name}"},
+	{"AutomationDscConfiguration", "Automation DSC Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/configurations/{name}"},
+	{"AutomationDscNodeConfiguration", "Automation DSC Node Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/nodeConfigurations/{name}"},
+	{"AutomationModule", "Automation Module", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/modules/{name}"},
+	{"AutomationRunbook", "Automation Runbook", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/runbooks/{name}"},
+	{"AutomationSchedule", "Automation Schedule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/schedules/{name}"},
+
+	// Batch
+	{"BatchAccount", "Batch Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{name}"},
+	{"BatchPool", "Batch Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{accountName}/pools/{name}"},
+
+	// CDN
+	{"CdnEndpoint", "CDN Endpoint", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{profileName}/endpoints/{name}"},
+	{"CdnProfile", "CDN Profile", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{name}"},
+
+	// Cognitive Services
+	{"CognitiveAccount", "Cognitive Services Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.CognitiveServices/accounts/{name}"},
+
+	// Compute
+	{"AvailabilitySet", "Availability Set", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/availabilitySets/{name}"},
+	{"Image", "Image", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/images/{name}"},
+	{"ManagedDisk", "Managed Disk", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{name}"},
+	{"SharedImage", "Shared Image", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{name}"},
+	{"SharedImageGallery", "Shared Image Gallery", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{name}"},
+	{"SharedImageVersion", "Shared Image Version", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{imageName}/versions/{name}"},
+	{"Snapshot", "Snapshot", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/snapshots/{name}"},
+	{"VirtualMachine", "Virtual Machine", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{name}"},
+	{"VirtualMachineExtension", "Virtual Machine Extension", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachineName}/extensions/{name}"},
+	{"VirtualMachineRunCommand", "Virtual Machine Run Command", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachineName}/runCommands/{name}"},
+	{"VirtualMachineScaleSet", "Virtual Machine Scale Set", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{name}"},
+	{"VirtualMachineScaleSetExtension", "Virtual Machine Scale Set Extension", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{virtualMachineScaleSetName}/extensions/{name}"},
+
+	// Containers
+	{"ContainerGroup", "Container Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerInstance/containerGroups/{name}"},
+	{"ContainerRegistry", "Container Registry", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerRegistry/registries/{name}"},
+	{"ContainerService", "Container Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/containerServices/{name}"},
+	{"KubernetesCluster", "Kubernetes Cluster", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{name}"},
+
+	// CosmosDB
+	{"CosmosDBAccount", "CosmosDB Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{name}"},
+
+	// Data Lake
+	{"DataLakeAnalyticsAccount", "Data Lake Analytics Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{name}"},
+	{"DataLakeAnalyticsFirewallRule", "Data Lake Analytics Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{accountName}/firewallRules/{name}"},
+	{"DataLakeStore", "Data Lake Store", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{name}"},
+	{"DataLakeStoreFirewallRule", "Data Lake Store Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{accountName}/firewallRules/{name}"},
+
+	// Databricks
+	{"DatabricksWorkspace", "Databricks Workspace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Databricks/workspaces/{name}"},
+
+	// Dev Test
+	{"DevTestLab", "Dev Test Lab", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{name}"},
+	{"DevTestVirtualMachine", "Dev Test Virtual Machine", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/virtualmachines/{name}"},
+	{"DevTestVirtualNetwork", "Dev Test Virtual Network", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/virtualnetworks/{name}"},
+	{"DevSpaceController", "DevSpace Controller", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevSpaces/controllers/{name}"},
+
+	// DNS
+	{"DnsZone", "DNS Zone", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{name}"},
+
+	// Event Grid
+	{"EventGridDomain", "EventGrid Domain", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/domains/{name}"},
+	{"EventGridTopic", "EventGrid Topic", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/topics/{name}"},
+
+	// Event Hubs
+	{"EventHub", "EventHub", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{name}"},
+	{"EventHubAuthorizationRule", "EventHub Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventHubName}/authorizationRules/{name}"},
+	{"EventHubConsumerGroup", "EventHub Consumer Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventHubName}/consumergroups/{name}"},
+	{"EventHubNamespace", "EventHub Namespace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{name}"},
+	{"EventHubNamespaceAuthorizationRule", "EventHub Namespace Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/authorizationRules/{name}"},
+
+	// IoT Hub
+	{"IotHub", "IoT Hub", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{name}"},
+
+	// Key Vault
+	{"KeyVault", "Key Vault", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}"},
+
+	// Log Analytics
+	{"LogAnalyticsSolution", "Log Analytics Solution", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationsManagement/solutions/{name}"},
+	{"LogAnalyticsWorkspace", "Log Analytics Workspace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{name}"},
+	{"LogAnalyticsLinkedService", "Log Analytics Linked Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/linkedServices/{name}"},
+
+	// Logic Apps
+	{"LogicAppWorkflow", "Logic App Workflow", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{name}"},
+
+	// Media
+	{"MediaServicesAccount", "Media Services Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{name}"},
+
+	// Monitor
+	{"MonitorActionGroup", "Action Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/actionGroups/{name}"},
+	{"MonitorActivityLogAlert", "Activity Log Alert", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/activityLogAlerts/{name}"},
+	{"MonitorAutoscaleSetting", "Autoscale Setting", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/autoscaleSettings/{name}"},
+	{"MonitorMetricAlert", "Metric Alert", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/metricAlerts/{name}"},
+	{"MonitorMetricAlertRule", "Metric Alert Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/alertRules/{name}"},
+
+	// MariaDB, MySQL & PostgreSQL
+	{"MariaDBDatabase", "MariaDB Database", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMariaDB/servers/{serverName}/databases/{name}"},
+	{"MariaDBServer", "MariaDB Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMariaDB/servers/{name}"},
+	{"MySQLConfiguration", "MySQL Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/configurations/{name}"},
+	{"MySQLDatabase", "MySQL Database", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/databases/{name}"},
+	{"MySQLFirewallRule", "MySQL Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/firewallRules/{name}"},
+	{"MySQLServer", "MySQL Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{name}"},
+	{"MySQLVirtualNetworkRule", "MySQL Virtual Network Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/virtualNetworkRules/{name}"},
+	{"PostgreSQLConfiguration", "PostgreSQL Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/configurations/{name}"},
+	{"PostgreSQLDatabase", "PostgreSQL Database", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/databases/{name}"},
+	{"PostgreSQLFirewallRule", "PostgreSQL Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/firewallRules/{name}"},
+	{"PostgreSQLServer", "PostgreSQL Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{name}"},
+	{"PostgreSQLVirtualNetworkRule", "PostgreSQL Virtual Network Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/virtualNetworkRules/{name}"},
+
+	// Network
+	{"ApplicationGateway", "Application Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{name}"},
+	{"ApplicationGatewayBackendAddressPool", "Application Gateway Backend Address Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{applicationGatewayName}/backendAddressPools/{name}"},
+	{"ApplicationSecurityGroup", "Application Security Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationSecurityGroups/{name}"},
+	{"ConnectionMonitor", "Connection Monitor", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcherName}/connectionMonitors/{name}"},
+	{"DdosProtectionPlan", "DDoS Protection Plan", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/ddosProtectionPlans/{name}"},
+	{"ExpressRouteCircuit", "ExpressRoute Circuit", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{name}"},
+	{"ExpressRouteCircuitAuthorization", "ExpressRoute Circuit Authorization", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{circuitName}/authorizations/{name}"},
+	{"ExpressRouteCircuitPeering", "ExpressRoute Circuit Peering", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{circuitName}/peerings/{name}"},
+	{"Firewall", "Firewall", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{name}"},
+	{"LoadBalancer", "Load Balancer", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{name}"},
+	{"LoadBalancerBackendAddressPool", "Load Balancer Backend Address Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/backendAddressPools/{name}"},
+	{"LoadBalancerFrontendIPConfiguration", "Load Balancer Frontend IP Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/frontendIPConfigurations/{name}"},
+	{"LoadBalancerInboundNatPool", "Load Balancer Inbound NAT Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatPools/{name}"},
+	{"LoadBalancerInboundNatRule", "Load Balancer Inbound NAT Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatRules/{name}"},
+	{"LoadBalancerOutboundRule", "Load Balancer Outbound Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/outboundRules/{name}"},
+	{"LoadBalancerProbe", "Load Balancer Probe", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/probes/{name}"},
+	{"LoadBalancerRule", "Load Balancer Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/loadBalancingRules/{name}"},
+	{"LocalNetworkGateway", "Local Network Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/localNetworkGateways/{name}"},
+	{"NetworkInterface", "Network Interface", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{name}"},
+	{"NetworkInterfaceIPConfiguration", "Network Interface IP Configuration", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{networkInterfaceName}/ipConfigurations/{name}"},
+	{"NetworkSecurityGroup", "Network Security Group", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{name}"},
+	{"NetworkSecurityRule", "Network Security Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroupName}/securityRules/{name}"},
+	{"NetworkWatcher", "Network Watcher", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{name}"},
+	{"PacketCapture", "Packet Capture", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcherName}/packetCaptures/{name}"},
+	{"PrivateEndpoint", "Private Endpoint", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/interfaceEndpoints/{name}"},
+	{"PublicIP", "Public IP", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPAddresses/{name}"},
+	{"PublicIPPrefix", "Public IP Prefix", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPPrefixes/{name}"},
+	{"Route", "Route", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{routeTableName}/routes/{name}"},
+	{"RouteFilter", "Route Filter", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeFilters/{name}"},
+	{"RouteTable", "Route Table", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{name}"},
+	{"Subnet", "Subnet", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{name}"},
+	{"TrafficManagerProfile", "Traffic Manager Profile", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{name}"},
+	{"VirtualHub", "Virtual Hub", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{name}"},
+	{"VirtualHubConnection", "Virtual Hub Connection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHubName}/hubVirtualNetworkConnections/{name}"},
+	{"VirtualNetwork", "Virtual Network", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}"},
+	{"VirtualNetworkGateway", "Virtual Network Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{name}"},
+	{"VirtualNetworkGatewayConnection", "Virtual Network Gateway Connection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/connections/{name}"},
+	{"VirtualNetworkPeering", "Virtual Network Peering", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/virtualNetworkPeerings/{name}"},
+	{"VirtualWan", "Virtual WAN", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualWans/{name}"},
+	{"VpnGateway", "VPN Gateway", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{name}"},
+	{"VpnGatewayConnection", "VPN Gateway Connection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{vpnGatewayName}/vpnConnections/{name}"},
+	{"VpnSite", "VPN Site", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnSites/{name}"},
+
+	// Notification Hubs
+	{"NotificationHub", "Notification Hub", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespaceName}/notificationHubs/{name}"},
+	{"NotificationHubNamespace", "Notification Hub Namespace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{name}"},
+
+	// Recovery Services
+	{"RecoveryServicesVault", "Recovery Services Vault", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{name}"},
+	{"RecoveryServicesProtectionPolicy", "Recovery Services Protection Policy", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{vaultName}/backupPolicies/{name}"},
+
+	// Redis
+	{"RedisCache", "Redis Cache", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{name}"},
+	{"RedisFirewallRule", "Redis Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{cacheName}/firewallRules/{name}"},
+
+	// Relay
+	{"RelayNamespace", "Relay Namespace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{name}"},
+
+	// Resources
+	{"ResourceGroup", "Resource Group", "/subscriptions/{subscriptionId}/resourceGroups/{name}"},
+	{"TemplateDeployment", "Template Deployment", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Resources/deployments/{name}"},
+	{"UserAssignedIdentity", "User Assigned Identity", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}"},
+
+	// Scheduler
+	{"SchedulerJob", "Scheduler Job", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{jobCollectionName}/jobs/{name}"},
+	{"SchedulerJobCollection", "Scheduler Job Collection", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{name}"},
+
+	// Search
+	{"SearchService", "Search Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Search/searchServices/{name}"},
+
+	// Service Bus
+	{"ServiceBusNamespace", "ServiceBus Namespace", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{name}"},
+	{"ServiceBusNamespaceAuthorizationRule", "ServiceBus Namespace Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/authorizationRules/{name}"},
+	{"ServiceBusQueue", "ServiceBus Queue", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{name}"},
+	{"ServiceBusQueueAuthorizationRule", "ServiceBus Queue Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{queueName}/authorizationRules/{name}"},
+	{"ServiceBusSubscription", "ServiceBus Subscription", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{name}"},
+	{"ServiceBusSubscriptionRule", "ServiceBus Subscription Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{subscriptionName}/rules/{name}"},
+	{"ServiceBusTopic", "ServiceBus Topic", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{name}"},
+	{"ServiceBusTopicAuthorizationRule", "ServiceBus Topic Authorization Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/authorizationRules/{name}"},
+
+	// Service Fabric
+	{"ServiceFabricCluster", "Service Fabric Cluster", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabric/clusters/{name}"},
+
+	// SignalR
+	{"SignalRService", "SignalR Service", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.SignalRService/SignalR/{name}"},
+
+	// SQL
+	{"SqlDatabase", "SQL Database", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{name}"},
+	{"SqlElasticPool", "SQL Elastic Pool", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/elasticPools/{name}"},
+	{"SqlFirewallRule", "SQL Firewall Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/firewallRules/{name}"},
+	{"SqlServer", "SQL Server", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}"},
+	{"SqlVirtualNetworkRule", "SQL Virtual Network Rule", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/virtualNetworkRules/{name}"},
+
+	// Storage
+	{"StorageAccount", "Storage Account", "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}"},
+}
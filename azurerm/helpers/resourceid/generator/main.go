@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+type definition struct {
+	name        string
+	description string
+	format      string
+}
+
+type field struct {
+	Name     string
+	Argument string
+}
+
+type segment struct {
+	Key        string
+	FixedValue string
+}
+
+type resourceId struct {
+	Name        string
+	FormatName  string
+	Description string
+	Format      string
+	Fields      []field
+	Segments    []segment
+}
+
+func main() {
+	output := flag.String("output", "ids.go", "The path of the file the Resource ID's should be written to")
+	flag.Parse()
+
+	ids := make([]resourceId, 0)
+	for _, d := range definitions {
+		id, err := parseDefinition(d)
+		if err != nil {
+			log.Fatalf("Error parsing the definition for %q: %+v", d.name, err)
+		}
+
+		ids = append(ids, *id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Name < ids[j].Name
+	})
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, ids); err != nil {
+		log.Fatalf("Error generating the Resource ID's: %+v", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Error formatting the generated Resource ID's: %+v", err)
+	}
+
+	if err := ioutil.WriteFile(*output, formatted, 0644); err != nil {
+		log.Fatalf("Error writing %q: %+v", *output, err)
+	}
+}
+
+func parseDefinition(d definition) (*resourceId, error) {
+	components := strings.Split(strings.TrimPrefix(d.format, "/"), "/")
+	if len(components)%2 != 0 {
+		return nil, fmt.Errorf("The number of segments in %q isn't divisible by 2", d.format)
+	}
+
+	id := resourceId{
+		Name:        d.name + "Id",
+		FormatName:  strings.ToLower(d.name[0:1]) + d.name[1:] + "IdFormat",
+		Description: d.description,
+		Format:      d.format,
+	}
+
+	for i := 0; i < len(components); i += 2 {
+		key := components[i]
+		value := components[i+1]
+
+		if !strings.HasPrefix(value, "{") || !strings.HasSuffix(value, "}") {
+			id.Segments = append(id.Segments, segment{Key: key, FixedValue: value})
+			continue
+		}
+
+		argument := strings.TrimSuffix(strings.TrimPrefix(value, "{"), "}")
+		id.Segments = append(id.Segments, segment{Key: key})
+		id.Fields = append(id.Fields, field{
+			Name:     strings.ToUpper(argument[0:1]) + argument[1:],
+			Argument: argument,
+		})
+	}
+
+	return &id, nil
+}
+
+var fileTemplate = template.Must(template.New("ids").Funcs(template.FuncMap{
+	"article": func(description string) string {
+		if strings.ContainsAny(description[0:1], "AEIOU") {
+			return "an " + description
+		}
+		return "a " + description
+	},
+	"arguments": func(fields []field) string {
+		arguments := make([]string, 0)
+		for _, f := range fields {
+			arguments = append(arguments, f.Argument)
+		}
+		return strings.Join(arguments, ", ")
+	},
+	"values": func(fields []field) string {
+		values := make([]string, 0)
+		for _, f := range fields {
+			values = append(values, "id."+f.Name)
+		}
+		return strings.Join(values, ", ")
+	},
+}).Parse(`// Code generated by ./generator; DO NOT EDIT.
+
+package resourceid
+{{ range . }}
+// {{ .Name }} is the ID of {{ article .Description }} in the format ` + "`{{ .Format }}`" + `
+type {{ .Name }} struct {
+{{- range .Fields }}
+	{{ .Name }} string
+{{- end }}
+}
+
+var {{ .FormatName }} = idFormat{
+	description: "{{ .Description }}",
+	segments: []segment{
+{{- range .Segments }}
+		{key: "{{ .Key }}"{{ if .FixedValue }}, fixedValue: "{{ .FixedValue }}"{{ end }}},
+{{- end }}
+	},
+}
+
+// New{{ .Name }} returns the ID of the {{ .Description }}
+func New{{ .Name }}({{ arguments .Fields }} string) {{ .Name }} {
+	return {{ .Name }}{
+{{- range .Fields }}
+		{{ .Name }}: {{ .Argument }},
+{{- end }}
+	}
+}
+
+// Parse{{ .Name }} parses the ID of {{ article .Description }}
+func Parse{{ .Name }}(input string) (*{{ .Name }}, error) {
+	values, err := {{ .FormatName }}.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{ .Name }}{
+{{- range $i, $f := .Fields }}
+		{{ $f.Name }}: values[{{ $i }}],
+{{- end }}
+	}, nil
+}
+
+// String returns the ID of the {{ .Description }}
+func (id {{ .Name }}) String() string {
+	return {{ .FormatName }}.format({{ values .Fields }})
+}
+
+// Validate{{ .Name }} validates that the value is the ID of {{ article .Description }}
+func Validate{{ .Name }}(i interface{}, k string) (warnings []string, errors []error) {
+	return {{ .FormatName }}.validate(i, k)
+}
+{{ end }}`))
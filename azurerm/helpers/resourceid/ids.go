@@ -0,0 +1,8354 @@
+// Code generated by ./generator; DO NOT EDIT.
+
+package resourceid
+
+// ApiManagementApiId is the ID of an API Management API in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{name}`
+type ApiManagementApiId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementApiIdFormat = idFormat{
+	description: "API Management API",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "apis"},
+	},
+}
+
+// NewApiManagementApiId returns the ID of the API Management API
+func NewApiManagementApiId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementApiId {
+	return ApiManagementApiId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementApiId parses the ID of an API Management API
+func ParseApiManagementApiId(input string) (*ApiManagementApiId, error) {
+	values, err := apiManagementApiIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementApiId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management API
+func (id ApiManagementApiId) String() string {
+	return apiManagementApiIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementApiId validates that the value is the ID of an API Management API
+func ValidateApiManagementApiId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementApiIdFormat.validate(i, k)
+}
+
+// ApiManagementApiOperationId is the ID of an API Management API Operation in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/apis/{apiName}/operations/{name}`
+type ApiManagementApiOperationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	ApiName        string
+	Name           string
+}
+
+var apiManagementApiOperationIdFormat = idFormat{
+	description: "API Management API Operation",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "apis"},
+		{key: "operations"},
+	},
+}
+
+// NewApiManagementApiOperationId returns the ID of the API Management API Operation
+func NewApiManagementApiOperationId(subscriptionId, resourceGroup, serviceName, apiName, name string) ApiManagementApiOperationId {
+	return ApiManagementApiOperationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		ApiName:        apiName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementApiOperationId parses the ID of an API Management API Operation
+func ParseApiManagementApiOperationId(input string) (*ApiManagementApiOperationId, error) {
+	values, err := apiManagementApiOperationIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementApiOperationId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		ApiName:        values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// String returns the ID of the API Management API Operation
+func (id ApiManagementApiOperationId) String() string {
+	return apiManagementApiOperationIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.Name)
+}
+
+// ValidateApiManagementApiOperationId validates that the value is the ID of an API Management API Operation
+func ValidateApiManagementApiOperationId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementApiOperationIdFormat.validate(i, k)
+}
+
+// ApiManagementApiVersionSetId is the ID of an API Management API Version Set in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/api-version-sets/{name}`
+type ApiManagementApiVersionSetId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementApiVersionSetIdFormat = idFormat{
+	description: "API Management API Version Set",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "api-version-sets"},
+	},
+}
+
+// NewApiManagementApiVersionSetId returns the ID of the API Management API Version Set
+func NewApiManagementApiVersionSetId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementApiVersionSetId {
+	return ApiManagementApiVersionSetId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementApiVersionSetId parses the ID of an API Management API Version Set
+func ParseApiManagementApiVersionSetId(input string) (*ApiManagementApiVersionSetId, error) {
+	values, err := apiManagementApiVersionSetIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementApiVersionSetId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management API Version Set
+func (id ApiManagementApiVersionSetId) String() string {
+	return apiManagementApiVersionSetIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementApiVersionSetId validates that the value is the ID of an API Management API Version Set
+func ValidateApiManagementApiVersionSetId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementApiVersionSetIdFormat.validate(i, k)
+}
+
+// ApiManagementAuthorizationServerId is the ID of an API Management Authorization Server in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/authorizationServers/{name}`
+type ApiManagementAuthorizationServerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementAuthorizationServerIdFormat = idFormat{
+	description: "API Management Authorization Server",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "authorizationServers"},
+	},
+}
+
+// NewApiManagementAuthorizationServerId returns the ID of the API Management Authorization Server
+func NewApiManagementAuthorizationServerId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementAuthorizationServerId {
+	return ApiManagementAuthorizationServerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementAuthorizationServerId parses the ID of an API Management Authorization Server
+func ParseApiManagementAuthorizationServerId(input string) (*ApiManagementAuthorizationServerId, error) {
+	values, err := apiManagementAuthorizationServerIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementAuthorizationServerId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management Authorization Server
+func (id ApiManagementAuthorizationServerId) String() string {
+	return apiManagementAuthorizationServerIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementAuthorizationServerId validates that the value is the ID of an API Management Authorization Server
+func ValidateApiManagementAuthorizationServerId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementAuthorizationServerIdFormat.validate(i, k)
+}
+
+// ApiManagementCertificateId is the ID of an API Management Certificate in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/certificates/{name}`
+type ApiManagementCertificateId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementCertificateIdFormat = idFormat{
+	description: "API Management Certificate",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "certificates"},
+	},
+}
+
+// NewApiManagementCertificateId returns the ID of the API Management Certificate
+func NewApiManagementCertificateId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementCertificateId {
+	return ApiManagementCertificateId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementCertificateId parses the ID of an API Management Certificate
+func ParseApiManagementCertificateId(input string) (*ApiManagementCertificateId, error) {
+	values, err := apiManagementCertificateIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementCertificateId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management Certificate
+func (id ApiManagementCertificateId) String() string {
+	return apiManagementCertificateIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementCertificateId validates that the value is the ID of an API Management Certificate
+func ValidateApiManagementCertificateId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementCertificateIdFormat.validate(i, k)
+}
+
+// ApiManagementGroupId is the ID of an API Management Group in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/groups/{name}`
+type ApiManagementGroupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementGroupIdFormat = idFormat{
+	description: "API Management Group",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "groups"},
+	},
+}
+
+// NewApiManagementGroupId returns the ID of the API Management Group
+func NewApiManagementGroupId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementGroupId {
+	return ApiManagementGroupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementGroupId parses the ID of an API Management Group
+func ParseApiManagementGroupId(input string) (*ApiManagementGroupId, error) {
+	values, err := apiManagementGroupIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementGroupId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management Group
+func (id ApiManagementGroupId) String() string {
+	return apiManagementGroupIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementGroupId validates that the value is the ID of an API Management Group
+func ValidateApiManagementGroupId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementGroupIdFormat.validate(i, k)
+}
+
+// ApiManagementId is the ID of an API Management Service in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{name}`
+type ApiManagementId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var apiManagementIdFormat = idFormat{
+	description: "API Management Service",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+	},
+}
+
+// NewApiManagementId returns the ID of the API Management Service
+func NewApiManagementId(subscriptionId, resourceGroup, name string) ApiManagementId {
+	return ApiManagementId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementId parses the ID of an API Management Service
+func ParseApiManagementId(input string) (*ApiManagementId, error) {
+	values, err := apiManagementIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the API Management Service
+func (id ApiManagementId) String() string {
+	return apiManagementIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateApiManagementId validates that the value is the ID of an API Management Service
+func ValidateApiManagementId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementIdFormat.validate(i, k)
+}
+
+// ApiManagementLoggerId is the ID of an API Management Logger in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/loggers/{name}`
+type ApiManagementLoggerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementLoggerIdFormat = idFormat{
+	description: "API Management Logger",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "loggers"},
+	},
+}
+
+// NewApiManagementLoggerId returns the ID of the API Management Logger
+func NewApiManagementLoggerId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementLoggerId {
+	return ApiManagementLoggerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementLoggerId parses the ID of an API Management Logger
+func ParseApiManagementLoggerId(input string) (*ApiManagementLoggerId, error) {
+	values, err := apiManagementLoggerIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementLoggerId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management Logger
+func (id ApiManagementLoggerId) String() string {
+	return apiManagementLoggerIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementLoggerId validates that the value is the ID of an API Management Logger
+func ValidateApiManagementLoggerId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementLoggerIdFormat.validate(i, k)
+}
+
+// ApiManagementOpenIDConnectProviderId is the ID of an API Management OpenID Connect Provider in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/openidConnectProviders/{name}`
+type ApiManagementOpenIDConnectProviderId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementOpenIDConnectProviderIdFormat = idFormat{
+	description: "API Management OpenID Connect Provider",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "openidConnectProviders"},
+	},
+}
+
+// NewApiManagementOpenIDConnectProviderId returns the ID of the API Management OpenID Connect Provider
+func NewApiManagementOpenIDConnectProviderId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementOpenIDConnectProviderId {
+	return ApiManagementOpenIDConnectProviderId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementOpenIDConnectProviderId parses the ID of an API Management OpenID Connect Provider
+func ParseApiManagementOpenIDConnectProviderId(input string) (*ApiManagementOpenIDConnectProviderId, error) {
+	values, err := apiManagementOpenIDConnectProviderIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementOpenIDConnectProviderId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management OpenID Connect Provider
+func (id ApiManagementOpenIDConnectProviderId) String() string {
+	return apiManagementOpenIDConnectProviderIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementOpenIDConnectProviderId validates that the value is the ID of an API Management OpenID Connect Provider
+func ValidateApiManagementOpenIDConnectProviderId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementOpenIDConnectProviderIdFormat.validate(i, k)
+}
+
+// ApiManagementProductId is the ID of an API Management Product in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/products/{name}`
+type ApiManagementProductId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementProductIdFormat = idFormat{
+	description: "API Management Product",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "products"},
+	},
+}
+
+// NewApiManagementProductId returns the ID of the API Management Product
+func NewApiManagementProductId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementProductId {
+	return ApiManagementProductId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementProductId parses the ID of an API Management Product
+func ParseApiManagementProductId(input string) (*ApiManagementProductId, error) {
+	values, err := apiManagementProductIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementProductId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management Product
+func (id ApiManagementProductId) String() string {
+	return apiManagementProductIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementProductId validates that the value is the ID of an API Management Product
+func ValidateApiManagementProductId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementProductIdFormat.validate(i, k)
+}
+
+// ApiManagementPropertyId is the ID of an API Management Property in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/properties/{name}`
+type ApiManagementPropertyId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementPropertyIdFormat = idFormat{
+	description: "API Management Property",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "properties"},
+	},
+}
+
+// NewApiManagementPropertyId returns the ID of the API Management Property
+func NewApiManagementPropertyId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementPropertyId {
+	return ApiManagementPropertyId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementPropertyId parses the ID of an API Management Property
+func ParseApiManagementPropertyId(input string) (*ApiManagementPropertyId, error) {
+	values, err := apiManagementPropertyIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementPropertyId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management Property
+func (id ApiManagementPropertyId) String() string {
+	return apiManagementPropertyIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementPropertyId validates that the value is the ID of an API Management Property
+func ValidateApiManagementPropertyId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementPropertyIdFormat.validate(i, k)
+}
+
+// ApiManagementSubscriptionId is the ID of an API Management Subscription in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/subscriptions/{name}`
+type ApiManagementSubscriptionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementSubscriptionIdFormat = idFormat{
+	description: "API Management Subscription",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "subscriptions"},
+	},
+}
+
+// NewApiManagementSubscriptionId returns the ID of the API Management Subscription
+func NewApiManagementSubscriptionId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementSubscriptionId {
+	return ApiManagementSubscriptionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementSubscriptionId parses the ID of an API Management Subscription
+func ParseApiManagementSubscriptionId(input string) (*ApiManagementSubscriptionId, error) {
+	values, err := apiManagementSubscriptionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementSubscriptionId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management Subscription
+func (id ApiManagementSubscriptionId) String() string {
+	return apiManagementSubscriptionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementSubscriptionId validates that the value is the ID of an API Management Subscription
+func ValidateApiManagementSubscriptionId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementSubscriptionIdFormat.validate(i, k)
+}
+
+// ApiManagementUserId is the ID of an API Management User in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ApiManagement/service/{serviceName}/users/{name}`
+type ApiManagementUserId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	Name           string
+}
+
+var apiManagementUserIdFormat = idFormat{
+	description: "API Management User",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ApiManagement"},
+		{key: "service"},
+		{key: "users"},
+	},
+}
+
+// NewApiManagementUserId returns the ID of the API Management User
+func NewApiManagementUserId(subscriptionId, resourceGroup, serviceName, name string) ApiManagementUserId {
+	return ApiManagementUserId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		Name:           name,
+	}
+}
+
+// ParseApiManagementUserId parses the ID of an API Management User
+func ParseApiManagementUserId(input string) (*ApiManagementUserId, error) {
+	values, err := apiManagementUserIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApiManagementUserId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServiceName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the API Management User
+func (id ApiManagementUserId) String() string {
+	return apiManagementUserIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+}
+
+// ValidateApiManagementUserId validates that the value is the ID of an API Management User
+func ValidateApiManagementUserId(i interface{}, k string) (warnings []string, errors []error) {
+	return apiManagementUserIdFormat.validate(i, k)
+}
+
+// AppServiceCustomHostnameBindingId is the ID of an App Service Custom Hostname Binding in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{appServiceName}/hostNameBindings/{name}`
+type AppServiceCustomHostnameBindingId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AppServiceName string
+	Name           string
+}
+
+var appServiceCustomHostnameBindingIdFormat = idFormat{
+	description: "App Service Custom Hostname Binding",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Web"},
+		{key: "sites"},
+		{key: "hostNameBindings"},
+	},
+}
+
+// NewAppServiceCustomHostnameBindingId returns the ID of the App Service Custom Hostname Binding
+func NewAppServiceCustomHostnameBindingId(subscriptionId, resourceGroup, appServiceName, name string) AppServiceCustomHostnameBindingId {
+	return AppServiceCustomHostnameBindingId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AppServiceName: appServiceName,
+		Name:           name,
+	}
+}
+
+// ParseAppServiceCustomHostnameBindingId parses the ID of an App Service Custom Hostname Binding
+func ParseAppServiceCustomHostnameBindingId(input string) (*AppServiceCustomHostnameBindingId, error) {
+	values, err := appServiceCustomHostnameBindingIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServiceCustomHostnameBindingId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AppServiceName: values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the App Service Custom Hostname Binding
+func (id AppServiceCustomHostnameBindingId) String() string {
+	return appServiceCustomHostnameBindingIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AppServiceName, id.Name)
+}
+
+// ValidateAppServiceCustomHostnameBindingId validates that the value is the ID of an App Service Custom Hostname Binding
+func ValidateAppServiceCustomHostnameBindingId(i interface{}, k string) (warnings []string, errors []error) {
+	return appServiceCustomHostnameBindingIdFormat.validate(i, k)
+}
+
+// AppServiceId is the ID of an App Service in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{name}`
+type AppServiceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var appServiceIdFormat = idFormat{
+	description: "App Service",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Web"},
+		{key: "sites"},
+	},
+}
+
+// NewAppServiceId returns the ID of the App Service
+func NewAppServiceId(subscriptionId, resourceGroup, name string) AppServiceId {
+	return AppServiceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseAppServiceId parses the ID of an App Service
+func ParseAppServiceId(input string) (*AppServiceId, error) {
+	values, err := appServiceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServiceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the App Service
+func (id AppServiceId) String() string {
+	return appServiceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateAppServiceId validates that the value is the ID of an App Service
+func ValidateAppServiceId(i interface{}, k string) (warnings []string, errors []error) {
+	return appServiceIdFormat.validate(i, k)
+}
+
+// AppServicePlanId is the ID of an App Service Plan in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/serverfarms/{name}`
+type AppServicePlanId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var appServicePlanIdFormat = idFormat{
+	description: "App Service Plan",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Web"},
+		{key: "serverfarms"},
+	},
+}
+
+// NewAppServicePlanId returns the ID of the App Service Plan
+func NewAppServicePlanId(subscriptionId, resourceGroup, name string) AppServicePlanId {
+	return AppServicePlanId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseAppServicePlanId parses the ID of an App Service Plan
+func ParseAppServicePlanId(input string) (*AppServicePlanId, error) {
+	values, err := appServicePlanIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServicePlanId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the App Service Plan
+func (id AppServicePlanId) String() string {
+	return appServicePlanIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateAppServicePlanId validates that the value is the ID of an App Service Plan
+func ValidateAppServicePlanId(i interface{}, k string) (warnings []string, errors []error) {
+	return appServicePlanIdFormat.validate(i, k)
+}
+
+// AppServiceSlotId is the ID of an App Service Slot in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{appServiceName}/slots/{name}`
+type AppServiceSlotId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AppServiceName string
+	Name           string
+}
+
+var appServiceSlotIdFormat = idFormat{
+	description: "App Service Slot",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Web"},
+		{key: "sites"},
+		{key: "slots"},
+	},
+}
+
+// NewAppServiceSlotId returns the ID of the App Service Slot
+func NewAppServiceSlotId(subscriptionId, resourceGroup, appServiceName, name string) AppServiceSlotId {
+	return AppServiceSlotId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AppServiceName: appServiceName,
+		Name:           name,
+	}
+}
+
+// ParseAppServiceSlotId parses the ID of an App Service Slot
+func ParseAppServiceSlotId(input string) (*AppServiceSlotId, error) {
+	values, err := appServiceSlotIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServiceSlotId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AppServiceName: values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the App Service Slot
+func (id AppServiceSlotId) String() string {
+	return appServiceSlotIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AppServiceName, id.Name)
+}
+
+// ValidateAppServiceSlotId validates that the value is the ID of an App Service Slot
+func ValidateAppServiceSlotId(i interface{}, k string) (warnings []string, errors []error) {
+	return appServiceSlotIdFormat.validate(i, k)
+}
+
+// ApplicationGatewayBackendAddressPoolId is the ID of an Application Gateway Backend Address Pool in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{applicationGatewayName}/backendAddressPools/{name}`
+type ApplicationGatewayBackendAddressPoolId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	ApplicationGatewayName string
+	Name                   string
+}
+
+var applicationGatewayBackendAddressPoolIdFormat = idFormat{
+	description: "Application Gateway Backend Address Pool",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "applicationGateways"},
+		{key: "backendAddressPools"},
+	},
+}
+
+// NewApplicationGatewayBackendAddressPoolId returns the ID of the Application Gateway Backend Address Pool
+func NewApplicationGatewayBackendAddressPoolId(subscriptionId, resourceGroup, applicationGatewayName, name string) ApplicationGatewayBackendAddressPoolId {
+	return ApplicationGatewayBackendAddressPoolId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		ApplicationGatewayName: applicationGatewayName,
+		Name:                   name,
+	}
+}
+
+// ParseApplicationGatewayBackendAddressPoolId parses the ID of an Application Gateway Backend Address Pool
+func ParseApplicationGatewayBackendAddressPoolId(input string) (*ApplicationGatewayBackendAddressPoolId, error) {
+	values, err := applicationGatewayBackendAddressPoolIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationGatewayBackendAddressPoolId{
+		SubscriptionId:         values[0],
+		ResourceGroup:          values[1],
+		ApplicationGatewayName: values[2],
+		Name:                   values[3],
+	}, nil
+}
+
+// String returns the ID of the Application Gateway Backend Address Pool
+func (id ApplicationGatewayBackendAddressPoolId) String() string {
+	return applicationGatewayBackendAddressPoolIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.Name)
+}
+
+// ValidateApplicationGatewayBackendAddressPoolId validates that the value is the ID of an Application Gateway Backend Address Pool
+func ValidateApplicationGatewayBackendAddressPoolId(i interface{}, k string) (warnings []string, errors []error) {
+	return applicationGatewayBackendAddressPoolIdFormat.validate(i, k)
+}
+
+// ApplicationGatewayId is the ID of an Application Gateway in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{name}`
+type ApplicationGatewayId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var applicationGatewayIdFormat = idFormat{
+	description: "Application Gateway",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "applicationGateways"},
+	},
+}
+
+// NewApplicationGatewayId returns the ID of the Application Gateway
+func NewApplicationGatewayId(subscriptionId, resourceGroup, name string) ApplicationGatewayId {
+	return ApplicationGatewayId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseApplicationGatewayId parses the ID of an Application Gateway
+func ParseApplicationGatewayId(input string) (*ApplicationGatewayId, error) {
+	values, err := applicationGatewayIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationGatewayId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Application Gateway
+func (id ApplicationGatewayId) String() string {
+	return applicationGatewayIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateApplicationGatewayId validates that the value is the ID of an Application Gateway
+func ValidateApplicationGatewayId(i interface{}, k string) (warnings []string, errors []error) {
+	return applicationGatewayIdFormat.validate(i, k)
+}
+
+// ApplicationInsightsApiKeyId is the ID of an Application Insights API Key in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{componentName}/apiKeys/{name}`
+type ApplicationInsightsApiKeyId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ComponentName  string
+	Name           string
+}
+
+var applicationInsightsApiKeyIdFormat = idFormat{
+	description: "Application Insights API Key",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Insights"},
+		{key: "components"},
+		{key: "apiKeys"},
+	},
+}
+
+// NewApplicationInsightsApiKeyId returns the ID of the Application Insights API Key
+func NewApplicationInsightsApiKeyId(subscriptionId, resourceGroup, componentName, name string) ApplicationInsightsApiKeyId {
+	return ApplicationInsightsApiKeyId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ComponentName:  componentName,
+		Name:           name,
+	}
+}
+
+// ParseApplicationInsightsApiKeyId parses the ID of an Application Insights API Key
+func ParseApplicationInsightsApiKeyId(input string) (*ApplicationInsightsApiKeyId, error) {
+	values, err := applicationInsightsApiKeyIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationInsightsApiKeyId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ComponentName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Application Insights API Key
+func (id ApplicationInsightsApiKeyId) String() string {
+	return applicationInsightsApiKeyIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ComponentName, id.Name)
+}
+
+// ValidateApplicationInsightsApiKeyId validates that the value is the ID of an Application Insights API Key
+func ValidateApplicationInsightsApiKeyId(i interface{}, k string) (warnings []string, errors []error) {
+	return applicationInsightsApiKeyIdFormat.validate(i, k)
+}
+
+// ApplicationInsightsId is the ID of an Application Insights in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{name}`
+type ApplicationInsightsId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var applicationInsightsIdFormat = idFormat{
+	description: "Application Insights",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Insights"},
+		{key: "components"},
+	},
+}
+
+// NewApplicationInsightsId returns the ID of the Application Insights
+func NewApplicationInsightsId(subscriptionId, resourceGroup, name string) ApplicationInsightsId {
+	return ApplicationInsightsId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseApplicationInsightsId parses the ID of an Application Insights
+func ParseApplicationInsightsId(input string) (*ApplicationInsightsId, error) {
+	values, err := applicationInsightsIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationInsightsId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Application Insights
+func (id ApplicationInsightsId) String() string {
+	return applicationInsightsIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateApplicationInsightsId validates that the value is the ID of an Application Insights
+func ValidateApplicationInsightsId(i interface{}, k string) (warnings []string, errors []error) {
+	return applicationInsightsIdFormat.validate(i, k)
+}
+
+// ApplicationSecurityGroupId is the ID of an Application Security Group in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationSecurityGroups/{name}`
+type ApplicationSecurityGroupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var applicationSecurityGroupIdFormat = idFormat{
+	description: "Application Security Group",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "applicationSecurityGroups"},
+	},
+}
+
+// NewApplicationSecurityGroupId returns the ID of the Application Security Group
+func NewApplicationSecurityGroupId(subscriptionId, resourceGroup, name string) ApplicationSecurityGroupId {
+	return ApplicationSecurityGroupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseApplicationSecurityGroupId parses the ID of an Application Security Group
+func ParseApplicationSecurityGroupId(input string) (*ApplicationSecurityGroupId, error) {
+	values, err := applicationSecurityGroupIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationSecurityGroupId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Application Security Group
+func (id ApplicationSecurityGroupId) String() string {
+	return applicationSecurityGroupIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateApplicationSecurityGroupId validates that the value is the ID of an Application Security Group
+func ValidateApplicationSecurityGroupId(i interface{}, k string) (warnings []string, errors []error) {
+	return applicationSecurityGroupIdFormat.validate(i, k)
+}
+
+// AutomationAccountId is the ID of an Automation Account in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{name}`
+type AutomationAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var automationAccountIdFormat = idFormat{
+	description: "Automation Account",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Automation"},
+		{key: "automationAccounts"},
+	},
+}
+
+// NewAutomationAccountId returns the ID of the Automation Account
+func NewAutomationAccountId(subscriptionId, resourceGroup, name string) AutomationAccountId {
+	return AutomationAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseAutomationAccountId parses the ID of an Automation Account
+func ParseAutomationAccountId(input string) (*AutomationAccountId, error) {
+	values, err := automationAccountIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationAccountId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Automation Account
+func (id AutomationAccountId) String() string {
+	return automationAccountIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateAutomationAccountId validates that the value is the ID of an Automation Account
+func ValidateAutomationAccountId(i interface{}, k string) (warnings []string, errors []error) {
+	return automationAccountIdFormat.validate(i, k)
+}
+
+// AutomationCredentialId is the ID of an Automation Credential in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/credentials/{name}`
+type AutomationCredentialId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+var automationCredentialIdFormat = idFormat{
+	description: "Automation Credential",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Automation"},
+		{key: "automationAccounts"},
+		{key: "credentials"},
+	},
+}
+
+// NewAutomationCredentialId returns the ID of the Automation Credential
+func NewAutomationCredentialId(subscriptionId, resourceGroup, accountName, name string) AutomationCredentialId {
+	return AutomationCredentialId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ParseAutomationCredentialId parses the ID of an Automation Credential
+func ParseAutomationCredentialId(input string) (*AutomationCredentialId, error) {
+	values, err := automationCredentialIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationCredentialId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Automation Credential
+func (id AutomationCredentialId) String() string {
+	return automationCredentialIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ValidateAutomationCredentialId validates that the value is the ID of an Automation Credential
+func ValidateAutomationCredentialId(i interface{}, k string) (warnings []string, errors []error) {
+	return automationCredentialIdFormat.validate(i, k)
+}
+
+// AutomationDscConfigurationId is the ID of an Automation DSC Configuration in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/configurations/{name}`
+type AutomationDscConfigurationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+var automationDscConfigurationIdFormat = idFormat{
+	description: "Automation DSC Configuration",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Automation"},
+		{key: "automationAccounts"},
+		{key: "configurations"},
+	},
+}
+
+// NewAutomationDscConfigurationId returns the ID of the Automation DSC Configuration
+func NewAutomationDscConfigurationId(subscriptionId, resourceGroup, accountName, name string) AutomationDscConfigurationId {
+	return AutomationDscConfigurationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ParseAutomationDscConfigurationId parses the ID of an Automation DSC Configuration
+func ParseAutomationDscConfigurationId(input string) (*AutomationDscConfigurationId, error) {
+	values, err := automationDscConfigurationIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationDscConfigurationId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Automation DSC Configuration
+func (id AutomationDscConfigurationId) String() string {
+	return automationDscConfigurationIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ValidateAutomationDscConfigurationId validates that the value is the ID of an Automation DSC Configuration
+func ValidateAutomationDscConfigurationId(i interface{}, k string) (warnings []string, errors []error) {
+	return automationDscConfigurationIdFormat.validate(i, k)
+}
+
+// AutomationDscNodeConfigurationId is the ID of an Automation DSC Node Configuration in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/nodeConfigurations/{name}`
+type AutomationDscNodeConfigurationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+var automationDscNodeConfigurationIdFormat = idFormat{
+	description: "Automation DSC Node Configuration",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Automation"},
+		{key: "automationAccounts"},
+		{key: "nodeConfigurations"},
+	},
+}
+
+// NewAutomationDscNodeConfigurationId returns the ID of the Automation DSC Node Configuration
+func NewAutomationDscNodeConfigurationId(subscriptionId, resourceGroup, accountName, name string) AutomationDscNodeConfigurationId {
+	return AutomationDscNodeConfigurationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ParseAutomationDscNodeConfigurationId parses the ID of an Automation DSC Node Configuration
+func ParseAutomationDscNodeConfigurationId(input string) (*AutomationDscNodeConfigurationId, error) {
+	values, err := automationDscNodeConfigurationIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationDscNodeConfigurationId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Automation DSC Node Configuration
+func (id AutomationDscNodeConfigurationId) String() string {
+	return automationDscNodeConfigurationIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ValidateAutomationDscNodeConfigurationId validates that the value is the ID of an Automation DSC Node Configuration
+func ValidateAutomationDscNodeConfigurationId(i interface{}, k string) (warnings []string, errors []error) {
+	return automationDscNodeConfigurationIdFormat.validate(i, k)
+}
+
+// AutomationModuleId is the ID of an Automation Module in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/modules/{name}`
+type AutomationModuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+var automationModuleIdFormat = idFormat{
+	description: "Automation Module",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Automation"},
+		{key: "automationAccounts"},
+		{key: "modules"},
+	},
+}
+
+// NewAutomationModuleId returns the ID of the Automation Module
+func NewAutomationModuleId(subscriptionId, resourceGroup, accountName, name string) AutomationModuleId {
+	return AutomationModuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ParseAutomationModuleId parses the ID of an Automation Module
+func ParseAutomationModuleId(input string) (*AutomationModuleId, error) {
+	values, err := automationModuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationModuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Automation Module
+func (id AutomationModuleId) String() string {
+	return automationModuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ValidateAutomationModuleId validates that the value is the ID of an Automation Module
+func ValidateAutomationModuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return automationModuleIdFormat.validate(i, k)
+}
+
+// AutomationRunbookId is the ID of an Automation Runbook in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/runbooks/{name}`
+type AutomationRunbookId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+var automationRunbookIdFormat = idFormat{
+	description: "Automation Runbook",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Automation"},
+		{key: "automationAccounts"},
+		{key: "runbooks"},
+	},
+}
+
+// NewAutomationRunbookId returns the ID of the Automation Runbook
+func NewAutomationRunbookId(subscriptionId, resourceGroup, accountName, name string) AutomationRunbookId {
+	return AutomationRunbookId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ParseAutomationRunbookId parses the ID of an Automation Runbook
+func ParseAutomationRunbookId(input string) (*AutomationRunbookId, error) {
+	values, err := automationRunbookIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationRunbookId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Automation Runbook
+func (id AutomationRunbookId) String() string {
+	return automationRunbookIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ValidateAutomationRunbookId validates that the value is the ID of an Automation Runbook
+func ValidateAutomationRunbookId(i interface{}, k string) (warnings []string, errors []error) {
+	return automationRunbookIdFormat.validate(i, k)
+}
+
+// AutomationScheduleId is the ID of an Automation Schedule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{accountName}/schedules/{name}`
+type AutomationScheduleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+var automationScheduleIdFormat = idFormat{
+	description: "Automation Schedule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Automation"},
+		{key: "automationAccounts"},
+		{key: "schedules"},
+	},
+}
+
+// NewAutomationScheduleId returns the ID of the Automation Schedule
+func NewAutomationScheduleId(subscriptionId, resourceGroup, accountName, name string) AutomationScheduleId {
+	return AutomationScheduleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ParseAutomationScheduleId parses the ID of an Automation Schedule
+func ParseAutomationScheduleId(input string) (*AutomationScheduleId, error) {
+	values, err := automationScheduleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AutomationScheduleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Automation Schedule
+func (id AutomationScheduleId) String() string {
+	return automationScheduleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ValidateAutomationScheduleId validates that the value is the ID of an Automation Schedule
+func ValidateAutomationScheduleId(i interface{}, k string) (warnings []string, errors []error) {
+	return automationScheduleIdFormat.validate(i, k)
+}
+
+// AvailabilitySetId is the ID of an Availability Set in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/availabilitySets/{name}`
+type AvailabilitySetId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var availabilitySetIdFormat = idFormat{
+	description: "Availability Set",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "availabilitySets"},
+	},
+}
+
+// NewAvailabilitySetId returns the ID of the Availability Set
+func NewAvailabilitySetId(subscriptionId, resourceGroup, name string) AvailabilitySetId {
+	return AvailabilitySetId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseAvailabilitySetId parses the ID of an Availability Set
+func ParseAvailabilitySetId(input string) (*AvailabilitySetId, error) {
+	values, err := availabilitySetIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvailabilitySetId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Availability Set
+func (id AvailabilitySetId) String() string {
+	return availabilitySetIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateAvailabilitySetId validates that the value is the ID of an Availability Set
+func ValidateAvailabilitySetId(i interface{}, k string) (warnings []string, errors []error) {
+	return availabilitySetIdFormat.validate(i, k)
+}
+
+// BatchAccountId is the ID of a Batch Account in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{name}`
+type BatchAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var batchAccountIdFormat = idFormat{
+	description: "Batch Account",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Batch"},
+		{key: "batchAccounts"},
+	},
+}
+
+// NewBatchAccountId returns the ID of the Batch Account
+func NewBatchAccountId(subscriptionId, resourceGroup, name string) BatchAccountId {
+	return BatchAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseBatchAccountId parses the ID of a Batch Account
+func ParseBatchAccountId(input string) (*BatchAccountId, error) {
+	values, err := batchAccountIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchAccountId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Batch Account
+func (id BatchAccountId) String() string {
+	return batchAccountIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateBatchAccountId validates that the value is the ID of a Batch Account
+func ValidateBatchAccountId(i interface{}, k string) (warnings []string, errors []error) {
+	return batchAccountIdFormat.validate(i, k)
+}
+
+// BatchPoolId is the ID of a Batch Pool in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Batch/batchAccounts/{accountName}/pools/{name}`
+type BatchPoolId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+var batchPoolIdFormat = idFormat{
+	description: "Batch Pool",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Batch"},
+		{key: "batchAccounts"},
+		{key: "pools"},
+	},
+}
+
+// NewBatchPoolId returns the ID of the Batch Pool
+func NewBatchPoolId(subscriptionId, resourceGroup, accountName, name string) BatchPoolId {
+	return BatchPoolId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ParseBatchPoolId parses the ID of a Batch Pool
+func ParseBatchPoolId(input string) (*BatchPoolId, error) {
+	values, err := batchPoolIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchPoolId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Batch Pool
+func (id BatchPoolId) String() string {
+	return batchPoolIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ValidateBatchPoolId validates that the value is the ID of a Batch Pool
+func ValidateBatchPoolId(i interface{}, k string) (warnings []string, errors []error) {
+	return batchPoolIdFormat.validate(i, k)
+}
+
+// CdnEndpointId is the ID of a CDN Endpoint in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{profileName}/endpoints/{name}`
+type CdnEndpointId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ProfileName    string
+	Name           string
+}
+
+var cdnEndpointIdFormat = idFormat{
+	description: "CDN Endpoint",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Cdn"},
+		{key: "profiles"},
+		{key: "endpoints"},
+	},
+}
+
+// NewCdnEndpointId returns the ID of the CDN Endpoint
+func NewCdnEndpointId(subscriptionId, resourceGroup, profileName, name string) CdnEndpointId {
+	return CdnEndpointId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ProfileName:    profileName,
+		Name:           name,
+	}
+}
+
+// ParseCdnEndpointId parses the ID of a CDN Endpoint
+func ParseCdnEndpointId(input string) (*CdnEndpointId, error) {
+	values, err := cdnEndpointIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CdnEndpointId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ProfileName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the CDN Endpoint
+func (id CdnEndpointId) String() string {
+	return cdnEndpointIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.Name)
+}
+
+// ValidateCdnEndpointId validates that the value is the ID of a CDN Endpoint
+func ValidateCdnEndpointId(i interface{}, k string) (warnings []string, errors []error) {
+	return cdnEndpointIdFormat.validate(i, k)
+}
+
+// CdnProfileId is the ID of a CDN Profile in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{name}`
+type CdnProfileId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var cdnProfileIdFormat = idFormat{
+	description: "CDN Profile",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Cdn"},
+		{key: "profiles"},
+	},
+}
+
+// NewCdnProfileId returns the ID of the CDN Profile
+func NewCdnProfileId(subscriptionId, resourceGroup, name string) CdnProfileId {
+	return CdnProfileId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseCdnProfileId parses the ID of a CDN Profile
+func ParseCdnProfileId(input string) (*CdnProfileId, error) {
+	values, err := cdnProfileIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CdnProfileId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the CDN Profile
+func (id CdnProfileId) String() string {
+	return cdnProfileIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateCdnProfileId validates that the value is the ID of a CDN Profile
+func ValidateCdnProfileId(i interface{}, k string) (warnings []string, errors []error) {
+	return cdnProfileIdFormat.validate(i, k)
+}
+
+// CognitiveAccountId is the ID of a Cognitive Services Account in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.CognitiveServices/accounts/{name}`
+type CognitiveAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var cognitiveAccountIdFormat = idFormat{
+	description: "Cognitive Services Account",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.CognitiveServices"},
+		{key: "accounts"},
+	},
+}
+
+// NewCognitiveAccountId returns the ID of the Cognitive Services Account
+func NewCognitiveAccountId(subscriptionId, resourceGroup, name string) CognitiveAccountId {
+	return CognitiveAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseCognitiveAccountId parses the ID of a Cognitive Services Account
+func ParseCognitiveAccountId(input string) (*CognitiveAccountId, error) {
+	values, err := cognitiveAccountIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CognitiveAccountId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Cognitive Services Account
+func (id CognitiveAccountId) String() string {
+	return cognitiveAccountIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateCognitiveAccountId validates that the value is the ID of a Cognitive Services Account
+func ValidateCognitiveAccountId(i interface{}, k string) (warnings []string, errors []error) {
+	return cognitiveAccountIdFormat.validate(i, k)
+}
+
+// ConnectionMonitorId is the ID of a Connection Monitor in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcherName}/connectionMonitors/{name}`
+type ConnectionMonitorId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkWatcherName string
+	Name               string
+}
+
+var connectionMonitorIdFormat = idFormat{
+	description: "Connection Monitor",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "networkWatchers"},
+		{key: "connectionMonitors"},
+	},
+}
+
+// NewConnectionMonitorId returns the ID of the Connection Monitor
+func NewConnectionMonitorId(subscriptionId, resourceGroup, networkWatcherName, name string) ConnectionMonitorId {
+	return ConnectionMonitorId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkWatcherName: networkWatcherName,
+		Name:               name,
+	}
+}
+
+// ParseConnectionMonitorId parses the ID of a Connection Monitor
+func ParseConnectionMonitorId(input string) (*ConnectionMonitorId, error) {
+	values, err := connectionMonitorIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConnectionMonitorId{
+		SubscriptionId:     values[0],
+		ResourceGroup:      values[1],
+		NetworkWatcherName: values[2],
+		Name:               values[3],
+	}, nil
+}
+
+// String returns the ID of the Connection Monitor
+func (id ConnectionMonitorId) String() string {
+	return connectionMonitorIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+}
+
+// ValidateConnectionMonitorId validates that the value is the ID of a Connection Monitor
+func ValidateConnectionMonitorId(i interface{}, k string) (warnings []string, errors []error) {
+	return connectionMonitorIdFormat.validate(i, k)
+}
+
+// ContainerGroupId is the ID of a Container Group in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerInstance/containerGroups/{name}`
+type ContainerGroupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var containerGroupIdFormat = idFormat{
+	description: "Container Group",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ContainerInstance"},
+		{key: "containerGroups"},
+	},
+}
+
+// NewContainerGroupId returns the ID of the Container Group
+func NewContainerGroupId(subscriptionId, resourceGroup, name string) ContainerGroupId {
+	return ContainerGroupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseContainerGroupId parses the ID of a Container Group
+func ParseContainerGroupId(input string) (*ContainerGroupId, error) {
+	values, err := containerGroupIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerGroupId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Container Group
+func (id ContainerGroupId) String() string {
+	return containerGroupIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateContainerGroupId validates that the value is the ID of a Container Group
+func ValidateContainerGroupId(i interface{}, k string) (warnings []string, errors []error) {
+	return containerGroupIdFormat.validate(i, k)
+}
+
+// ContainerRegistryId is the ID of a Container Registry in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerRegistry/registries/{name}`
+type ContainerRegistryId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var containerRegistryIdFormat = idFormat{
+	description: "Container Registry",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ContainerRegistry"},
+		{key: "registries"},
+	},
+}
+
+// NewContainerRegistryId returns the ID of the Container Registry
+func NewContainerRegistryId(subscriptionId, resourceGroup, name string) ContainerRegistryId {
+	return ContainerRegistryId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseContainerRegistryId parses the ID of a Container Registry
+func ParseContainerRegistryId(input string) (*ContainerRegistryId, error) {
+	values, err := containerRegistryIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerRegistryId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Container Registry
+func (id ContainerRegistryId) String() string {
+	return containerRegistryIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateContainerRegistryId validates that the value is the ID of a Container Registry
+func ValidateContainerRegistryId(i interface{}, k string) (warnings []string, errors []error) {
+	return containerRegistryIdFormat.validate(i, k)
+}
+
+// ContainerServiceId is the ID of a Container Service in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/containerServices/{name}`
+type ContainerServiceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var containerServiceIdFormat = idFormat{
+	description: "Container Service",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ContainerService"},
+		{key: "containerServices"},
+	},
+}
+
+// NewContainerServiceId returns the ID of the Container Service
+func NewContainerServiceId(subscriptionId, resourceGroup, name string) ContainerServiceId {
+	return ContainerServiceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseContainerServiceId parses the ID of a Container Service
+func ParseContainerServiceId(input string) (*ContainerServiceId, error) {
+	values, err := containerServiceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerServiceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Container Service
+func (id ContainerServiceId) String() string {
+	return containerServiceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateContainerServiceId validates that the value is the ID of a Container Service
+func ValidateContainerServiceId(i interface{}, k string) (warnings []string, errors []error) {
+	return containerServiceIdFormat.validate(i, k)
+}
+
+// CosmosDBAccountId is the ID of a CosmosDB Account in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{name}`
+type CosmosDBAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var cosmosDBAccountIdFormat = idFormat{
+	description: "CosmosDB Account",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DocumentDB"},
+		{key: "databaseAccounts"},
+	},
+}
+
+// NewCosmosDBAccountId returns the ID of the CosmosDB Account
+func NewCosmosDBAccountId(subscriptionId, resourceGroup, name string) CosmosDBAccountId {
+	return CosmosDBAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseCosmosDBAccountId parses the ID of a CosmosDB Account
+func ParseCosmosDBAccountId(input string) (*CosmosDBAccountId, error) {
+	values, err := cosmosDBAccountIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBAccountId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the CosmosDB Account
+func (id CosmosDBAccountId) String() string {
+	return cosmosDBAccountIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateCosmosDBAccountId validates that the value is the ID of a CosmosDB Account
+func ValidateCosmosDBAccountId(i interface{}, k string) (warnings []string, errors []error) {
+	return cosmosDBAccountIdFormat.validate(i, k)
+}
+
+// DataLakeAnalyticsAccountId is the ID of a Data Lake Analytics Account in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{name}`
+type DataLakeAnalyticsAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var dataLakeAnalyticsAccountIdFormat = idFormat{
+	description: "Data Lake Analytics Account",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DataLakeAnalytics"},
+		{key: "accounts"},
+	},
+}
+
+// NewDataLakeAnalyticsAccountId returns the ID of the Data Lake Analytics Account
+func NewDataLakeAnalyticsAccountId(subscriptionId, resourceGroup, name string) DataLakeAnalyticsAccountId {
+	return DataLakeAnalyticsAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseDataLakeAnalyticsAccountId parses the ID of a Data Lake Analytics Account
+func ParseDataLakeAnalyticsAccountId(input string) (*DataLakeAnalyticsAccountId, error) {
+	values, err := dataLakeAnalyticsAccountIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataLakeAnalyticsAccountId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Data Lake Analytics Account
+func (id DataLakeAnalyticsAccountId) String() string {
+	return dataLakeAnalyticsAccountIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateDataLakeAnalyticsAccountId validates that the value is the ID of a Data Lake Analytics Account
+func ValidateDataLakeAnalyticsAccountId(i interface{}, k string) (warnings []string, errors []error) {
+	return dataLakeAnalyticsAccountIdFormat.validate(i, k)
+}
+
+// DataLakeAnalyticsFirewallRuleId is the ID of a Data Lake Analytics Firewall Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{accountName}/firewallRules/{name}`
+type DataLakeAnalyticsFirewallRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+var dataLakeAnalyticsFirewallRuleIdFormat = idFormat{
+	description: "Data Lake Analytics Firewall Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DataLakeAnalytics"},
+		{key: "accounts"},
+		{key: "firewallRules"},
+	},
+}
+
+// NewDataLakeAnalyticsFirewallRuleId returns the ID of the Data Lake Analytics Firewall Rule
+func NewDataLakeAnalyticsFirewallRuleId(subscriptionId, resourceGroup, accountName, name string) DataLakeAnalyticsFirewallRuleId {
+	return DataLakeAnalyticsFirewallRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ParseDataLakeAnalyticsFirewallRuleId parses the ID of a Data Lake Analytics Firewall Rule
+func ParseDataLakeAnalyticsFirewallRuleId(input string) (*DataLakeAnalyticsFirewallRuleId, error) {
+	values, err := dataLakeAnalyticsFirewallRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataLakeAnalyticsFirewallRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Data Lake Analytics Firewall Rule
+func (id DataLakeAnalyticsFirewallRuleId) String() string {
+	return dataLakeAnalyticsFirewallRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ValidateDataLakeAnalyticsFirewallRuleId validates that the value is the ID of a Data Lake Analytics Firewall Rule
+func ValidateDataLakeAnalyticsFirewallRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return dataLakeAnalyticsFirewallRuleIdFormat.validate(i, k)
+}
+
+// DataLakeStoreFirewallRuleId is the ID of a Data Lake Store Firewall Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{accountName}/firewallRules/{name}`
+type DataLakeStoreFirewallRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+var dataLakeStoreFirewallRuleIdFormat = idFormat{
+	description: "Data Lake Store Firewall Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DataLakeStore"},
+		{key: "accounts"},
+		{key: "firewallRules"},
+	},
+}
+
+// NewDataLakeStoreFirewallRuleId returns the ID of the Data Lake Store Firewall Rule
+func NewDataLakeStoreFirewallRuleId(subscriptionId, resourceGroup, accountName, name string) DataLakeStoreFirewallRuleId {
+	return DataLakeStoreFirewallRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ParseDataLakeStoreFirewallRuleId parses the ID of a Data Lake Store Firewall Rule
+func ParseDataLakeStoreFirewallRuleId(input string) (*DataLakeStoreFirewallRuleId, error) {
+	values, err := dataLakeStoreFirewallRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataLakeStoreFirewallRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Data Lake Store Firewall Rule
+func (id DataLakeStoreFirewallRuleId) String() string {
+	return dataLakeStoreFirewallRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ValidateDataLakeStoreFirewallRuleId validates that the value is the ID of a Data Lake Store Firewall Rule
+func ValidateDataLakeStoreFirewallRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return dataLakeStoreFirewallRuleIdFormat.validate(i, k)
+}
+
+// DataLakeStoreId is the ID of a Data Lake Store in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{name}`
+type DataLakeStoreId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var dataLakeStoreIdFormat = idFormat{
+	description: "Data Lake Store",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DataLakeStore"},
+		{key: "accounts"},
+	},
+}
+
+// NewDataLakeStoreId returns the ID of the Data Lake Store
+func NewDataLakeStoreId(subscriptionId, resourceGroup, name string) DataLakeStoreId {
+	return DataLakeStoreId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseDataLakeStoreId parses the ID of a Data Lake Store
+func ParseDataLakeStoreId(input string) (*DataLakeStoreId, error) {
+	values, err := dataLakeStoreIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataLakeStoreId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Data Lake Store
+func (id DataLakeStoreId) String() string {
+	return dataLakeStoreIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateDataLakeStoreId validates that the value is the ID of a Data Lake Store
+func ValidateDataLakeStoreId(i interface{}, k string) (warnings []string, errors []error) {
+	return dataLakeStoreIdFormat.validate(i, k)
+}
+
+// DatabricksWorkspaceId is the ID of a Databricks Workspace in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Databricks/workspaces/{name}`
+type DatabricksWorkspaceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var databricksWorkspaceIdFormat = idFormat{
+	description: "Databricks Workspace",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Databricks"},
+		{key: "workspaces"},
+	},
+}
+
+// NewDatabricksWorkspaceId returns the ID of the Databricks Workspace
+func NewDatabricksWorkspaceId(subscriptionId, resourceGroup, name string) DatabricksWorkspaceId {
+	return DatabricksWorkspaceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseDatabricksWorkspaceId parses the ID of a Databricks Workspace
+func ParseDatabricksWorkspaceId(input string) (*DatabricksWorkspaceId, error) {
+	values, err := databricksWorkspaceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DatabricksWorkspaceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Databricks Workspace
+func (id DatabricksWorkspaceId) String() string {
+	return databricksWorkspaceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateDatabricksWorkspaceId validates that the value is the ID of a Databricks Workspace
+func ValidateDatabricksWorkspaceId(i interface{}, k string) (warnings []string, errors []error) {
+	return databricksWorkspaceIdFormat.validate(i, k)
+}
+
+// DdosProtectionPlanId is the ID of a DDoS Protection Plan in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/ddosProtectionPlans/{name}`
+type DdosProtectionPlanId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var ddosProtectionPlanIdFormat = idFormat{
+	description: "DDoS Protection Plan",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "ddosProtectionPlans"},
+	},
+}
+
+// NewDdosProtectionPlanId returns the ID of the DDoS Protection Plan
+func NewDdosProtectionPlanId(subscriptionId, resourceGroup, name string) DdosProtectionPlanId {
+	return DdosProtectionPlanId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseDdosProtectionPlanId parses the ID of a DDoS Protection Plan
+func ParseDdosProtectionPlanId(input string) (*DdosProtectionPlanId, error) {
+	values, err := ddosProtectionPlanIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DdosProtectionPlanId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the DDoS Protection Plan
+func (id DdosProtectionPlanId) String() string {
+	return ddosProtectionPlanIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateDdosProtectionPlanId validates that the value is the ID of a DDoS Protection Plan
+func ValidateDdosProtectionPlanId(i interface{}, k string) (warnings []string, errors []error) {
+	return ddosProtectionPlanIdFormat.validate(i, k)
+}
+
+// DevSpaceControllerId is the ID of a DevSpace Controller in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevSpaces/controllers/{name}`
+type DevSpaceControllerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var devSpaceControllerIdFormat = idFormat{
+	description: "DevSpace Controller",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DevSpaces"},
+		{key: "controllers"},
+	},
+}
+
+// NewDevSpaceControllerId returns the ID of the DevSpace Controller
+func NewDevSpaceControllerId(subscriptionId, resourceGroup, name string) DevSpaceControllerId {
+	return DevSpaceControllerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseDevSpaceControllerId parses the ID of a DevSpace Controller
+func ParseDevSpaceControllerId(input string) (*DevSpaceControllerId, error) {
+	values, err := devSpaceControllerIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevSpaceControllerId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the DevSpace Controller
+func (id DevSpaceControllerId) String() string {
+	return devSpaceControllerIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateDevSpaceControllerId validates that the value is the ID of a DevSpace Controller
+func ValidateDevSpaceControllerId(i interface{}, k string) (warnings []string, errors []error) {
+	return devSpaceControllerIdFormat.validate(i, k)
+}
+
+// DevTestLabId is the ID of a Dev Test Lab in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{name}`
+type DevTestLabId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var devTestLabIdFormat = idFormat{
+	description: "Dev Test Lab",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DevTestLab"},
+		{key: "labs"},
+	},
+}
+
+// NewDevTestLabId returns the ID of the Dev Test Lab
+func NewDevTestLabId(subscriptionId, resourceGroup, name string) DevTestLabId {
+	return DevTestLabId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseDevTestLabId parses the ID of a Dev Test Lab
+func ParseDevTestLabId(input string) (*DevTestLabId, error) {
+	values, err := devTestLabIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevTestLabId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Dev Test Lab
+func (id DevTestLabId) String() string {
+	return devTestLabIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateDevTestLabId validates that the value is the ID of a Dev Test Lab
+func ValidateDevTestLabId(i interface{}, k string) (warnings []string, errors []error) {
+	return devTestLabIdFormat.validate(i, k)
+}
+
+// DevTestVirtualMachineId is the ID of a Dev Test Virtual Machine in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/virtualmachines/{name}`
+type DevTestVirtualMachineId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LabName        string
+	Name           string
+}
+
+var devTestVirtualMachineIdFormat = idFormat{
+	description: "Dev Test Virtual Machine",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DevTestLab"},
+		{key: "labs"},
+		{key: "virtualmachines"},
+	},
+}
+
+// NewDevTestVirtualMachineId returns the ID of the Dev Test Virtual Machine
+func NewDevTestVirtualMachineId(subscriptionId, resourceGroup, labName, name string) DevTestVirtualMachineId {
+	return DevTestVirtualMachineId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LabName:        labName,
+		Name:           name,
+	}
+}
+
+// ParseDevTestVirtualMachineId parses the ID of a Dev Test Virtual Machine
+func ParseDevTestVirtualMachineId(input string) (*DevTestVirtualMachineId, error) {
+	values, err := devTestVirtualMachineIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevTestVirtualMachineId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		LabName:        values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Dev Test Virtual Machine
+func (id DevTestVirtualMachineId) String() string {
+	return devTestVirtualMachineIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.LabName, id.Name)
+}
+
+// ValidateDevTestVirtualMachineId validates that the value is the ID of a Dev Test Virtual Machine
+func ValidateDevTestVirtualMachineId(i interface{}, k string) (warnings []string, errors []error) {
+	return devTestVirtualMachineIdFormat.validate(i, k)
+}
+
+// DevTestVirtualNetworkId is the ID of a Dev Test Virtual Network in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{labName}/virtualnetworks/{name}`
+type DevTestVirtualNetworkId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LabName        string
+	Name           string
+}
+
+var devTestVirtualNetworkIdFormat = idFormat{
+	description: "Dev Test Virtual Network",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DevTestLab"},
+		{key: "labs"},
+		{key: "virtualnetworks"},
+	},
+}
+
+// NewDevTestVirtualNetworkId returns the ID of the Dev Test Virtual Network
+func NewDevTestVirtualNetworkId(subscriptionId, resourceGroup, labName, name string) DevTestVirtualNetworkId {
+	return DevTestVirtualNetworkId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LabName:        labName,
+		Name:           name,
+	}
+}
+
+// ParseDevTestVirtualNetworkId parses the ID of a Dev Test Virtual Network
+func ParseDevTestVirtualNetworkId(input string) (*DevTestVirtualNetworkId, error) {
+	values, err := devTestVirtualNetworkIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DevTestVirtualNetworkId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		LabName:        values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Dev Test Virtual Network
+func (id DevTestVirtualNetworkId) String() string {
+	return devTestVirtualNetworkIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.LabName, id.Name)
+}
+
+// ValidateDevTestVirtualNetworkId validates that the value is the ID of a Dev Test Virtual Network
+func ValidateDevTestVirtualNetworkId(i interface{}, k string) (warnings []string, errors []error) {
+	return devTestVirtualNetworkIdFormat.validate(i, k)
+}
+
+// DnsZoneId is the ID of a DNS Zone in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{name}`
+type DnsZoneId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var dnsZoneIdFormat = idFormat{
+	description: "DNS Zone",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "dnszones"},
+	},
+}
+
+// NewDnsZoneId returns the ID of the DNS Zone
+func NewDnsZoneId(subscriptionId, resourceGroup, name string) DnsZoneId {
+	return DnsZoneId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseDnsZoneId parses the ID of a DNS Zone
+func ParseDnsZoneId(input string) (*DnsZoneId, error) {
+	values, err := dnsZoneIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsZoneId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the DNS Zone
+func (id DnsZoneId) String() string {
+	return dnsZoneIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateDnsZoneId validates that the value is the ID of a DNS Zone
+func ValidateDnsZoneId(i interface{}, k string) (warnings []string, errors []error) {
+	return dnsZoneIdFormat.validate(i, k)
+}
+
+// EventGridDomainId is the ID of an EventGrid Domain in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/domains/{name}`
+type EventGridDomainId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var eventGridDomainIdFormat = idFormat{
+	description: "EventGrid Domain",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.EventGrid"},
+		{key: "domains"},
+	},
+}
+
+// NewEventGridDomainId returns the ID of the EventGrid Domain
+func NewEventGridDomainId(subscriptionId, resourceGroup, name string) EventGridDomainId {
+	return EventGridDomainId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseEventGridDomainId parses the ID of an EventGrid Domain
+func ParseEventGridDomainId(input string) (*EventGridDomainId, error) {
+	values, err := eventGridDomainIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventGridDomainId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the EventGrid Domain
+func (id EventGridDomainId) String() string {
+	return eventGridDomainIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateEventGridDomainId validates that the value is the ID of an EventGrid Domain
+func ValidateEventGridDomainId(i interface{}, k string) (warnings []string, errors []error) {
+	return eventGridDomainIdFormat.validate(i, k)
+}
+
+// EventGridTopicId is the ID of an EventGrid Topic in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/topics/{name}`
+type EventGridTopicId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var eventGridTopicIdFormat = idFormat{
+	description: "EventGrid Topic",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.EventGrid"},
+		{key: "topics"},
+	},
+}
+
+// NewEventGridTopicId returns the ID of the EventGrid Topic
+func NewEventGridTopicId(subscriptionId, resourceGroup, name string) EventGridTopicId {
+	return EventGridTopicId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseEventGridTopicId parses the ID of an EventGrid Topic
+func ParseEventGridTopicId(input string) (*EventGridTopicId, error) {
+	values, err := eventGridTopicIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventGridTopicId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the EventGrid Topic
+func (id EventGridTopicId) String() string {
+	return eventGridTopicIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateEventGridTopicId validates that the value is the ID of an EventGrid Topic
+func ValidateEventGridTopicId(i interface{}, k string) (warnings []string, errors []error) {
+	return eventGridTopicIdFormat.validate(i, k)
+}
+
+// EventHubAuthorizationRuleId is the ID of an EventHub Authorization Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventHubName}/authorizationRules/{name}`
+type EventHubAuthorizationRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	EventHubName   string
+	Name           string
+}
+
+var eventHubAuthorizationRuleIdFormat = idFormat{
+	description: "EventHub Authorization Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.EventHub"},
+		{key: "namespaces"},
+		{key: "eventhubs"},
+		{key: "authorizationRules"},
+	},
+}
+
+// NewEventHubAuthorizationRuleId returns the ID of the EventHub Authorization Rule
+func NewEventHubAuthorizationRuleId(subscriptionId, resourceGroup, namespaceName, eventHubName, name string) EventHubAuthorizationRuleId {
+	return EventHubAuthorizationRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		EventHubName:   eventHubName,
+		Name:           name,
+	}
+}
+
+// ParseEventHubAuthorizationRuleId parses the ID of an EventHub Authorization Rule
+func ParseEventHubAuthorizationRuleId(input string) (*EventHubAuthorizationRuleId, error) {
+	values, err := eventHubAuthorizationRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubAuthorizationRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		EventHubName:   values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// String returns the ID of the EventHub Authorization Rule
+func (id EventHubAuthorizationRuleId) String() string {
+	return eventHubAuthorizationRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.EventHubName, id.Name)
+}
+
+// ValidateEventHubAuthorizationRuleId validates that the value is the ID of an EventHub Authorization Rule
+func ValidateEventHubAuthorizationRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return eventHubAuthorizationRuleIdFormat.validate(i, k)
+}
+
+// EventHubConsumerGroupId is the ID of an EventHub Consumer Group in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventHubName}/consumergroups/{name}`
+type EventHubConsumerGroupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	EventHubName   string
+	Name           string
+}
+
+var eventHubConsumerGroupIdFormat = idFormat{
+	description: "EventHub Consumer Group",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.EventHub"},
+		{key: "namespaces"},
+		{key: "eventhubs"},
+		{key: "consumergroups"},
+	},
+}
+
+// NewEventHubConsumerGroupId returns the ID of the EventHub Consumer Group
+func NewEventHubConsumerGroupId(subscriptionId, resourceGroup, namespaceName, eventHubName, name string) EventHubConsumerGroupId {
+	return EventHubConsumerGroupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		EventHubName:   eventHubName,
+		Name:           name,
+	}
+}
+
+// ParseEventHubConsumerGroupId parses the ID of an EventHub Consumer Group
+func ParseEventHubConsumerGroupId(input string) (*EventHubConsumerGroupId, error) {
+	values, err := eventHubConsumerGroupIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubConsumerGroupId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		EventHubName:   values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// String returns the ID of the EventHub Consumer Group
+func (id EventHubConsumerGroupId) String() string {
+	return eventHubConsumerGroupIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.EventHubName, id.Name)
+}
+
+// ValidateEventHubConsumerGroupId validates that the value is the ID of an EventHub Consumer Group
+func ValidateEventHubConsumerGroupId(i interface{}, k string) (warnings []string, errors []error) {
+	return eventHubConsumerGroupIdFormat.validate(i, k)
+}
+
+// EventHubId is the ID of an EventHub in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{name}`
+type EventHubId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+var eventHubIdFormat = idFormat{
+	description: "EventHub",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.EventHub"},
+		{key: "namespaces"},
+		{key: "eventhubs"},
+	},
+}
+
+// NewEventHubId returns the ID of the EventHub
+func NewEventHubId(subscriptionId, resourceGroup, namespaceName, name string) EventHubId {
+	return EventHubId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ParseEventHubId parses the ID of an EventHub
+func ParseEventHubId(input string) (*EventHubId, error) {
+	values, err := eventHubIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the EventHub
+func (id EventHubId) String() string {
+	return eventHubIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ValidateEventHubId validates that the value is the ID of an EventHub
+func ValidateEventHubId(i interface{}, k string) (warnings []string, errors []error) {
+	return eventHubIdFormat.validate(i, k)
+}
+
+// EventHubNamespaceAuthorizationRuleId is the ID of an EventHub Namespace Authorization Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/authorizationRules/{name}`
+type EventHubNamespaceAuthorizationRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+var eventHubNamespaceAuthorizationRuleIdFormat = idFormat{
+	description: "EventHub Namespace Authorization Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.EventHub"},
+		{key: "namespaces"},
+		{key: "authorizationRules"},
+	},
+}
+
+// NewEventHubNamespaceAuthorizationRuleId returns the ID of the EventHub Namespace Authorization Rule
+func NewEventHubNamespaceAuthorizationRuleId(subscriptionId, resourceGroup, namespaceName, name string) EventHubNamespaceAuthorizationRuleId {
+	return EventHubNamespaceAuthorizationRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ParseEventHubNamespaceAuthorizationRuleId parses the ID of an EventHub Namespace Authorization Rule
+func ParseEventHubNamespaceAuthorizationRuleId(input string) (*EventHubNamespaceAuthorizationRuleId, error) {
+	values, err := eventHubNamespaceAuthorizationRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubNamespaceAuthorizationRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the EventHub Namespace Authorization Rule
+func (id EventHubNamespaceAuthorizationRuleId) String() string {
+	return eventHubNamespaceAuthorizationRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ValidateEventHubNamespaceAuthorizationRuleId validates that the value is the ID of an EventHub Namespace Authorization Rule
+func ValidateEventHubNamespaceAuthorizationRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return eventHubNamespaceAuthorizationRuleIdFormat.validate(i, k)
+}
+
+// EventHubNamespaceId is the ID of an EventHub Namespace in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{name}`
+type EventHubNamespaceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var eventHubNamespaceIdFormat = idFormat{
+	description: "EventHub Namespace",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.EventHub"},
+		{key: "namespaces"},
+	},
+}
+
+// NewEventHubNamespaceId returns the ID of the EventHub Namespace
+func NewEventHubNamespaceId(subscriptionId, resourceGroup, name string) EventHubNamespaceId {
+	return EventHubNamespaceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseEventHubNamespaceId parses the ID of an EventHub Namespace
+func ParseEventHubNamespaceId(input string) (*EventHubNamespaceId, error) {
+	values, err := eventHubNamespaceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubNamespaceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the EventHub Namespace
+func (id EventHubNamespaceId) String() string {
+	return eventHubNamespaceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateEventHubNamespaceId validates that the value is the ID of an EventHub Namespace
+func ValidateEventHubNamespaceId(i interface{}, k string) (warnings []string, errors []error) {
+	return eventHubNamespaceIdFormat.validate(i, k)
+}
+
+// ExpressRouteCircuitAuthorizationId is the ID of an ExpressRoute Circuit Authorization in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{circuitName}/authorizations/{name}`
+type ExpressRouteCircuitAuthorizationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	CircuitName    string
+	Name           string
+}
+
+var expressRouteCircuitAuthorizationIdFormat = idFormat{
+	description: "ExpressRoute Circuit Authorization",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "expressRouteCircuits"},
+		{key: "authorizations"},
+	},
+}
+
+// NewExpressRouteCircuitAuthorizationId returns the ID of the ExpressRoute Circuit Authorization
+func NewExpressRouteCircuitAuthorizationId(subscriptionId, resourceGroup, circuitName, name string) ExpressRouteCircuitAuthorizationId {
+	return ExpressRouteCircuitAuthorizationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		CircuitName:    circuitName,
+		Name:           name,
+	}
+}
+
+// ParseExpressRouteCircuitAuthorizationId parses the ID of an ExpressRoute Circuit Authorization
+func ParseExpressRouteCircuitAuthorizationId(input string) (*ExpressRouteCircuitAuthorizationId, error) {
+	values, err := expressRouteCircuitAuthorizationIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpressRouteCircuitAuthorizationId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		CircuitName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the ExpressRoute Circuit Authorization
+func (id ExpressRouteCircuitAuthorizationId) String() string {
+	return expressRouteCircuitAuthorizationIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.CircuitName, id.Name)
+}
+
+// ValidateExpressRouteCircuitAuthorizationId validates that the value is the ID of an ExpressRoute Circuit Authorization
+func ValidateExpressRouteCircuitAuthorizationId(i interface{}, k string) (warnings []string, errors []error) {
+	return expressRouteCircuitAuthorizationIdFormat.validate(i, k)
+}
+
+// ExpressRouteCircuitId is the ID of an ExpressRoute Circuit in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{name}`
+type ExpressRouteCircuitId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var expressRouteCircuitIdFormat = idFormat{
+	description: "ExpressRoute Circuit",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "expressRouteCircuits"},
+	},
+}
+
+// NewExpressRouteCircuitId returns the ID of the ExpressRoute Circuit
+func NewExpressRouteCircuitId(subscriptionId, resourceGroup, name string) ExpressRouteCircuitId {
+	return ExpressRouteCircuitId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseExpressRouteCircuitId parses the ID of an ExpressRoute Circuit
+func ParseExpressRouteCircuitId(input string) (*ExpressRouteCircuitId, error) {
+	values, err := expressRouteCircuitIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpressRouteCircuitId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the ExpressRoute Circuit
+func (id ExpressRouteCircuitId) String() string {
+	return expressRouteCircuitIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateExpressRouteCircuitId validates that the value is the ID of an ExpressRoute Circuit
+func ValidateExpressRouteCircuitId(i interface{}, k string) (warnings []string, errors []error) {
+	return expressRouteCircuitIdFormat.validate(i, k)
+}
+
+// ExpressRouteCircuitPeeringId is the ID of an ExpressRoute Circuit Peering in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{circuitName}/peerings/{name}`
+type ExpressRouteCircuitPeeringId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	CircuitName    string
+	Name           string
+}
+
+var expressRouteCircuitPeeringIdFormat = idFormat{
+	description: "ExpressRoute Circuit Peering",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "expressRouteCircuits"},
+		{key: "peerings"},
+	},
+}
+
+// NewExpressRouteCircuitPeeringId returns the ID of the ExpressRoute Circuit Peering
+func NewExpressRouteCircuitPeeringId(subscriptionId, resourceGroup, circuitName, name string) ExpressRouteCircuitPeeringId {
+	return ExpressRouteCircuitPeeringId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		CircuitName:    circuitName,
+		Name:           name,
+	}
+}
+
+// ParseExpressRouteCircuitPeeringId parses the ID of an ExpressRoute Circuit Peering
+func ParseExpressRouteCircuitPeeringId(input string) (*ExpressRouteCircuitPeeringId, error) {
+	values, err := expressRouteCircuitPeeringIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpressRouteCircuitPeeringId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		CircuitName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the ExpressRoute Circuit Peering
+func (id ExpressRouteCircuitPeeringId) String() string {
+	return expressRouteCircuitPeeringIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.CircuitName, id.Name)
+}
+
+// ValidateExpressRouteCircuitPeeringId validates that the value is the ID of an ExpressRoute Circuit Peering
+func ValidateExpressRouteCircuitPeeringId(i interface{}, k string) (warnings []string, errors []error) {
+	return expressRouteCircuitPeeringIdFormat.validate(i, k)
+}
+
+// FirewallId is the ID of a Firewall in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{name}`
+type FirewallId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var firewallIdFormat = idFormat{
+	description: "Firewall",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "azureFirewalls"},
+	},
+}
+
+// NewFirewallId returns the ID of the Firewall
+func NewFirewallId(subscriptionId, resourceGroup, name string) FirewallId {
+	return FirewallId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseFirewallId parses the ID of a Firewall
+func ParseFirewallId(input string) (*FirewallId, error) {
+	values, err := firewallIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FirewallId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Firewall
+func (id FirewallId) String() string {
+	return firewallIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateFirewallId validates that the value is the ID of a Firewall
+func ValidateFirewallId(i interface{}, k string) (warnings []string, errors []error) {
+	return firewallIdFormat.validate(i, k)
+}
+
+// ImageId is the ID of an Image in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/images/{name}`
+type ImageId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var imageIdFormat = idFormat{
+	description: "Image",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "images"},
+	},
+}
+
+// NewImageId returns the ID of the Image
+func NewImageId(subscriptionId, resourceGroup, name string) ImageId {
+	return ImageId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseImageId parses the ID of an Image
+func ParseImageId(input string) (*ImageId, error) {
+	values, err := imageIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImageId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Image
+func (id ImageId) String() string {
+	return imageIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateImageId validates that the value is the ID of an Image
+func ValidateImageId(i interface{}, k string) (warnings []string, errors []error) {
+	return imageIdFormat.validate(i, k)
+}
+
+// IotHubId is the ID of an IoT Hub in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{name}`
+type IotHubId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var iotHubIdFormat = idFormat{
+	description: "IoT Hub",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Devices"},
+		{key: "IotHubs"},
+	},
+}
+
+// NewIotHubId returns the ID of the IoT Hub
+func NewIotHubId(subscriptionId, resourceGroup, name string) IotHubId {
+	return IotHubId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseIotHubId parses the ID of an IoT Hub
+func ParseIotHubId(input string) (*IotHubId, error) {
+	values, err := iotHubIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IotHubId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the IoT Hub
+func (id IotHubId) String() string {
+	return iotHubIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateIotHubId validates that the value is the ID of an IoT Hub
+func ValidateIotHubId(i interface{}, k string) (warnings []string, errors []error) {
+	return iotHubIdFormat.validate(i, k)
+}
+
+// KeyVaultId is the ID of a Key Vault in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}`
+type KeyVaultId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var keyVaultIdFormat = idFormat{
+	description: "Key Vault",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.KeyVault"},
+		{key: "vaults"},
+	},
+}
+
+// NewKeyVaultId returns the ID of the Key Vault
+func NewKeyVaultId(subscriptionId, resourceGroup, name string) KeyVaultId {
+	return KeyVaultId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseKeyVaultId parses the ID of a Key Vault
+func ParseKeyVaultId(input string) (*KeyVaultId, error) {
+	values, err := keyVaultIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyVaultId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Key Vault
+func (id KeyVaultId) String() string {
+	return keyVaultIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateKeyVaultId validates that the value is the ID of a Key Vault
+func ValidateKeyVaultId(i interface{}, k string) (warnings []string, errors []error) {
+	return keyVaultIdFormat.validate(i, k)
+}
+
+// KubernetesClusterId is the ID of a Kubernetes Cluster in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{name}`
+type KubernetesClusterId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var kubernetesClusterIdFormat = idFormat{
+	description: "Kubernetes Cluster",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ContainerService"},
+		{key: "managedClusters"},
+	},
+}
+
+// NewKubernetesClusterId returns the ID of the Kubernetes Cluster
+func NewKubernetesClusterId(subscriptionId, resourceGroup, name string) KubernetesClusterId {
+	return KubernetesClusterId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseKubernetesClusterId parses the ID of a Kubernetes Cluster
+func ParseKubernetesClusterId(input string) (*KubernetesClusterId, error) {
+	values, err := kubernetesClusterIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KubernetesClusterId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Kubernetes Cluster
+func (id KubernetesClusterId) String() string {
+	return kubernetesClusterIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateKubernetesClusterId validates that the value is the ID of a Kubernetes Cluster
+func ValidateKubernetesClusterId(i interface{}, k string) (warnings []string, errors []error) {
+	return kubernetesClusterIdFormat.validate(i, k)
+}
+
+// LoadBalancerBackendAddressPoolId is the ID of a Load Balancer Backend Address Pool in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/backendAddressPools/{name}`
+type LoadBalancerBackendAddressPoolId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+var loadBalancerBackendAddressPoolIdFormat = idFormat{
+	description: "Load Balancer Backend Address Pool",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "loadBalancers"},
+		{key: "backendAddressPools"},
+	},
+}
+
+// NewLoadBalancerBackendAddressPoolId returns the ID of the Load Balancer Backend Address Pool
+func NewLoadBalancerBackendAddressPoolId(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerBackendAddressPoolId {
+	return LoadBalancerBackendAddressPoolId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ParseLoadBalancerBackendAddressPoolId parses the ID of a Load Balancer Backend Address Pool
+func ParseLoadBalancerBackendAddressPoolId(input string) (*LoadBalancerBackendAddressPoolId, error) {
+	values, err := loadBalancerBackendAddressPoolIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerBackendAddressPoolId{
+		SubscriptionId:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// String returns the ID of the Load Balancer Backend Address Pool
+func (id LoadBalancerBackendAddressPoolId) String() string {
+	return loadBalancerBackendAddressPoolIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ValidateLoadBalancerBackendAddressPoolId validates that the value is the ID of a Load Balancer Backend Address Pool
+func ValidateLoadBalancerBackendAddressPoolId(i interface{}, k string) (warnings []string, errors []error) {
+	return loadBalancerBackendAddressPoolIdFormat.validate(i, k)
+}
+
+// LoadBalancerFrontendIPConfigurationId is the ID of a Load Balancer Frontend IP Configuration in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/frontendIPConfigurations/{name}`
+type LoadBalancerFrontendIPConfigurationId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+var loadBalancerFrontendIPConfigurationIdFormat = idFormat{
+	description: "Load Balancer Frontend IP Configuration",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "loadBalancers"},
+		{key: "frontendIPConfigurations"},
+	},
+}
+
+// NewLoadBalancerFrontendIPConfigurationId returns the ID of the Load Balancer Frontend IP Configuration
+func NewLoadBalancerFrontendIPConfigurationId(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerFrontendIPConfigurationId {
+	return LoadBalancerFrontendIPConfigurationId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ParseLoadBalancerFrontendIPConfigurationId parses the ID of a Load Balancer Frontend IP Configuration
+func ParseLoadBalancerFrontendIPConfigurationId(input string) (*LoadBalancerFrontendIPConfigurationId, error) {
+	values, err := loadBalancerFrontendIPConfigurationIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerFrontendIPConfigurationId{
+		SubscriptionId:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// String returns the ID of the Load Balancer Frontend IP Configuration
+func (id LoadBalancerFrontendIPConfigurationId) String() string {
+	return loadBalancerFrontendIPConfigurationIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ValidateLoadBalancerFrontendIPConfigurationId validates that the value is the ID of a Load Balancer Frontend IP Configuration
+func ValidateLoadBalancerFrontendIPConfigurationId(i interface{}, k string) (warnings []string, errors []error) {
+	return loadBalancerFrontendIPConfigurationIdFormat.validate(i, k)
+}
+
+// LoadBalancerId is the ID of a Load Balancer in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{name}`
+type LoadBalancerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var loadBalancerIdFormat = idFormat{
+	description: "Load Balancer",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "loadBalancers"},
+	},
+}
+
+// NewLoadBalancerId returns the ID of the Load Balancer
+func NewLoadBalancerId(subscriptionId, resourceGroup, name string) LoadBalancerId {
+	return LoadBalancerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseLoadBalancerId parses the ID of a Load Balancer
+func ParseLoadBalancerId(input string) (*LoadBalancerId, error) {
+	values, err := loadBalancerIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Load Balancer
+func (id LoadBalancerId) String() string {
+	return loadBalancerIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateLoadBalancerId validates that the value is the ID of a Load Balancer
+func ValidateLoadBalancerId(i interface{}, k string) (warnings []string, errors []error) {
+	return loadBalancerIdFormat.validate(i, k)
+}
+
+// LoadBalancerInboundNatPoolId is the ID of a Load Balancer Inbound NAT Pool in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatPools/{name}`
+type LoadBalancerInboundNatPoolId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+var loadBalancerInboundNatPoolIdFormat = idFormat{
+	description: "Load Balancer Inbound NAT Pool",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "loadBalancers"},
+		{key: "inboundNatPools"},
+	},
+}
+
+// NewLoadBalancerInboundNatPoolId returns the ID of the Load Balancer Inbound NAT Pool
+func NewLoadBalancerInboundNatPoolId(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerInboundNatPoolId {
+	return LoadBalancerInboundNatPoolId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ParseLoadBalancerInboundNatPoolId parses the ID of a Load Balancer Inbound NAT Pool
+func ParseLoadBalancerInboundNatPoolId(input string) (*LoadBalancerInboundNatPoolId, error) {
+	values, err := loadBalancerInboundNatPoolIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerInboundNatPoolId{
+		SubscriptionId:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// String returns the ID of the Load Balancer Inbound NAT Pool
+func (id LoadBalancerInboundNatPoolId) String() string {
+	return loadBalancerInboundNatPoolIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ValidateLoadBalancerInboundNatPoolId validates that the value is the ID of a Load Balancer Inbound NAT Pool
+func ValidateLoadBalancerInboundNatPoolId(i interface{}, k string) (warnings []string, errors []error) {
+	return loadBalancerInboundNatPoolIdFormat.validate(i, k)
+}
+
+// LoadBalancerInboundNatRuleId is the ID of a Load Balancer Inbound NAT Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatRules/{name}`
+type LoadBalancerInboundNatRuleId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+var loadBalancerInboundNatRuleIdFormat = idFormat{
+	description: "Load Balancer Inbound NAT Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "loadBalancers"},
+		{key: "inboundNatRules"},
+	},
+}
+
+// NewLoadBalancerInboundNatRuleId returns the ID of the Load Balancer Inbound NAT Rule
+func NewLoadBalancerInboundNatRuleId(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerInboundNatRuleId {
+	return LoadBalancerInboundNatRuleId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ParseLoadBalancerInboundNatRuleId parses the ID of a Load Balancer Inbound NAT Rule
+func ParseLoadBalancerInboundNatRuleId(input string) (*LoadBalancerInboundNatRuleId, error) {
+	values, err := loadBalancerInboundNatRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerInboundNatRuleId{
+		SubscriptionId:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// String returns the ID of the Load Balancer Inbound NAT Rule
+func (id LoadBalancerInboundNatRuleId) String() string {
+	return loadBalancerInboundNatRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ValidateLoadBalancerInboundNatRuleId validates that the value is the ID of a Load Balancer Inbound NAT Rule
+func ValidateLoadBalancerInboundNatRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return loadBalancerInboundNatRuleIdFormat.validate(i, k)
+}
+
+// LoadBalancerOutboundRuleId is the ID of a Load Balancer Outbound Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/outboundRules/{name}`
+type LoadBalancerOutboundRuleId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+var loadBalancerOutboundRuleIdFormat = idFormat{
+	description: "Load Balancer Outbound Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "loadBalancers"},
+		{key: "outboundRules"},
+	},
+}
+
+// NewLoadBalancerOutboundRuleId returns the ID of the Load Balancer Outbound Rule
+func NewLoadBalancerOutboundRuleId(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerOutboundRuleId {
+	return LoadBalancerOutboundRuleId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ParseLoadBalancerOutboundRuleId parses the ID of a Load Balancer Outbound Rule
+func ParseLoadBalancerOutboundRuleId(input string) (*LoadBalancerOutboundRuleId, error) {
+	values, err := loadBalancerOutboundRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerOutboundRuleId{
+		SubscriptionId:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// String returns the ID of the Load Balancer Outbound Rule
+func (id LoadBalancerOutboundRuleId) String() string {
+	return loadBalancerOutboundRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ValidateLoadBalancerOutboundRuleId validates that the value is the ID of a Load Balancer Outbound Rule
+func ValidateLoadBalancerOutboundRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return loadBalancerOutboundRuleIdFormat.validate(i, k)
+}
+
+// LoadBalancerProbeId is the ID of a Load Balancer Probe in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/probes/{name}`
+type LoadBalancerProbeId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+var loadBalancerProbeIdFormat = idFormat{
+	description: "Load Balancer Probe",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "loadBalancers"},
+		{key: "probes"},
+	},
+}
+
+// NewLoadBalancerProbeId returns the ID of the Load Balancer Probe
+func NewLoadBalancerProbeId(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerProbeId {
+	return LoadBalancerProbeId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ParseLoadBalancerProbeId parses the ID of a Load Balancer Probe
+func ParseLoadBalancerProbeId(input string) (*LoadBalancerProbeId, error) {
+	values, err := loadBalancerProbeIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerProbeId{
+		SubscriptionId:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// String returns the ID of the Load Balancer Probe
+func (id LoadBalancerProbeId) String() string {
+	return loadBalancerProbeIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ValidateLoadBalancerProbeId validates that the value is the ID of a Load Balancer Probe
+func ValidateLoadBalancerProbeId(i interface{}, k string) (warnings []string, errors []error) {
+	return loadBalancerProbeIdFormat.validate(i, k)
+}
+
+// LoadBalancerRuleId is the ID of a Load Balancer Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/loadBalancingRules/{name}`
+type LoadBalancerRuleId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+var loadBalancerRuleIdFormat = idFormat{
+	description: "Load Balancer Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "loadBalancers"},
+		{key: "loadBalancingRules"},
+	},
+}
+
+// NewLoadBalancerRuleId returns the ID of the Load Balancer Rule
+func NewLoadBalancerRuleId(subscriptionId, resourceGroup, loadBalancerName, name string) LoadBalancerRuleId {
+	return LoadBalancerRuleId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ParseLoadBalancerRuleId parses the ID of a Load Balancer Rule
+func ParseLoadBalancerRuleId(input string) (*LoadBalancerRuleId, error) {
+	values, err := loadBalancerRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerRuleId{
+		SubscriptionId:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// String returns the ID of the Load Balancer Rule
+func (id LoadBalancerRuleId) String() string {
+	return loadBalancerRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ValidateLoadBalancerRuleId validates that the value is the ID of a Load Balancer Rule
+func ValidateLoadBalancerRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return loadBalancerRuleIdFormat.validate(i, k)
+}
+
+// LocalNetworkGatewayId is the ID of a Local Network Gateway in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/localNetworkGateways/{name}`
+type LocalNetworkGatewayId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var localNetworkGatewayIdFormat = idFormat{
+	description: "Local Network Gateway",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "localNetworkGateways"},
+	},
+}
+
+// NewLocalNetworkGatewayId returns the ID of the Local Network Gateway
+func NewLocalNetworkGatewayId(subscriptionId, resourceGroup, name string) LocalNetworkGatewayId {
+	return LocalNetworkGatewayId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseLocalNetworkGatewayId parses the ID of a Local Network Gateway
+func ParseLocalNetworkGatewayId(input string) (*LocalNetworkGatewayId, error) {
+	values, err := localNetworkGatewayIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalNetworkGatewayId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Local Network Gateway
+func (id LocalNetworkGatewayId) String() string {
+	return localNetworkGatewayIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateLocalNetworkGatewayId validates that the value is the ID of a Local Network Gateway
+func ValidateLocalNetworkGatewayId(i interface{}, k string) (warnings []string, errors []error) {
+	return localNetworkGatewayIdFormat.validate(i, k)
+}
+
+// LogAnalyticsLinkedServiceId is the ID of a Log Analytics Linked Service in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/linkedServices/{name}`
+type LogAnalyticsLinkedServiceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkspaceName  string
+	Name           string
+}
+
+var logAnalyticsLinkedServiceIdFormat = idFormat{
+	description: "Log Analytics Linked Service",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.OperationalInsights"},
+		{key: "workspaces"},
+		{key: "linkedServices"},
+	},
+}
+
+// NewLogAnalyticsLinkedServiceId returns the ID of the Log Analytics Linked Service
+func NewLogAnalyticsLinkedServiceId(subscriptionId, resourceGroup, workspaceName, name string) LogAnalyticsLinkedServiceId {
+	return LogAnalyticsLinkedServiceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkspaceName:  workspaceName,
+		Name:           name,
+	}
+}
+
+// ParseLogAnalyticsLinkedServiceId parses the ID of a Log Analytics Linked Service
+func ParseLogAnalyticsLinkedServiceId(input string) (*LogAnalyticsLinkedServiceId, error) {
+	values, err := logAnalyticsLinkedServiceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogAnalyticsLinkedServiceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		WorkspaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Log Analytics Linked Service
+func (id LogAnalyticsLinkedServiceId) String() string {
+	return logAnalyticsLinkedServiceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name)
+}
+
+// ValidateLogAnalyticsLinkedServiceId validates that the value is the ID of a Log Analytics Linked Service
+func ValidateLogAnalyticsLinkedServiceId(i interface{}, k string) (warnings []string, errors []error) {
+	return logAnalyticsLinkedServiceIdFormat.validate(i, k)
+}
+
+// LogAnalyticsSolutionId is the ID of a Log Analytics Solution in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationsManagement/solutions/{name}`
+type LogAnalyticsSolutionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var logAnalyticsSolutionIdFormat = idFormat{
+	description: "Log Analytics Solution",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.OperationsManagement"},
+		{key: "solutions"},
+	},
+}
+
+// NewLogAnalyticsSolutionId returns the ID of the Log Analytics Solution
+func NewLogAnalyticsSolutionId(subscriptionId, resourceGroup, name string) LogAnalyticsSolutionId {
+	return LogAnalyticsSolutionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseLogAnalyticsSolutionId parses the ID of a Log Analytics Solution
+func ParseLogAnalyticsSolutionId(input string) (*LogAnalyticsSolutionId, error) {
+	values, err := logAnalyticsSolutionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogAnalyticsSolutionId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Log Analytics Solution
+func (id LogAnalyticsSolutionId) String() string {
+	return logAnalyticsSolutionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateLogAnalyticsSolutionId validates that the value is the ID of a Log Analytics Solution
+func ValidateLogAnalyticsSolutionId(i interface{}, k string) (warnings []string, errors []error) {
+	return logAnalyticsSolutionIdFormat.validate(i, k)
+}
+
+// LogAnalyticsWorkspaceId is the ID of a Log Analytics Workspace in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{name}`
+type LogAnalyticsWorkspaceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var logAnalyticsWorkspaceIdFormat = idFormat{
+	description: "Log Analytics Workspace",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.OperationalInsights"},
+		{key: "workspaces"},
+	},
+}
+
+// NewLogAnalyticsWorkspaceId returns the ID of the Log Analytics Workspace
+func NewLogAnalyticsWorkspaceId(subscriptionId, resourceGroup, name string) LogAnalyticsWorkspaceId {
+	return LogAnalyticsWorkspaceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseLogAnalyticsWorkspaceId parses the ID of a Log Analytics Workspace
+func ParseLogAnalyticsWorkspaceId(input string) (*LogAnalyticsWorkspaceId, error) {
+	values, err := logAnalyticsWorkspaceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogAnalyticsWorkspaceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Log Analytics Workspace
+func (id LogAnalyticsWorkspaceId) String() string {
+	return logAnalyticsWorkspaceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateLogAnalyticsWorkspaceId validates that the value is the ID of a Log Analytics Workspace
+func ValidateLogAnalyticsWorkspaceId(i interface{}, k string) (warnings []string, errors []error) {
+	return logAnalyticsWorkspaceIdFormat.validate(i, k)
+}
+
+// LogicAppWorkflowId is the ID of a Logic App Workflow in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{name}`
+type LogicAppWorkflowId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var logicAppWorkflowIdFormat = idFormat{
+	description: "Logic App Workflow",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Logic"},
+		{key: "workflows"},
+	},
+}
+
+// NewLogicAppWorkflowId returns the ID of the Logic App Workflow
+func NewLogicAppWorkflowId(subscriptionId, resourceGroup, name string) LogicAppWorkflowId {
+	return LogicAppWorkflowId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseLogicAppWorkflowId parses the ID of a Logic App Workflow
+func ParseLogicAppWorkflowId(input string) (*LogicAppWorkflowId, error) {
+	values, err := logicAppWorkflowIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogicAppWorkflowId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Logic App Workflow
+func (id LogicAppWorkflowId) String() string {
+	return logicAppWorkflowIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateLogicAppWorkflowId validates that the value is the ID of a Logic App Workflow
+func ValidateLogicAppWorkflowId(i interface{}, k string) (warnings []string, errors []error) {
+	return logicAppWorkflowIdFormat.validate(i, k)
+}
+
+// ManagedDiskId is the ID of a Managed Disk in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{name}`
+type ManagedDiskId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var managedDiskIdFormat = idFormat{
+	description: "Managed Disk",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "disks"},
+	},
+}
+
+// NewManagedDiskId returns the ID of the Managed Disk
+func NewManagedDiskId(subscriptionId, resourceGroup, name string) ManagedDiskId {
+	return ManagedDiskId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseManagedDiskId parses the ID of a Managed Disk
+func ParseManagedDiskId(input string) (*ManagedDiskId, error) {
+	values, err := managedDiskIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedDiskId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Managed Disk
+func (id ManagedDiskId) String() string {
+	return managedDiskIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateManagedDiskId validates that the value is the ID of a Managed Disk
+func ValidateManagedDiskId(i interface{}, k string) (warnings []string, errors []error) {
+	return managedDiskIdFormat.validate(i, k)
+}
+
+// MariaDBDatabaseId is the ID of a MariaDB Database in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMariaDB/servers/{serverName}/databases/{name}`
+type MariaDBDatabaseId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var mariaDBDatabaseIdFormat = idFormat{
+	description: "MariaDB Database",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforMariaDB"},
+		{key: "servers"},
+		{key: "databases"},
+	},
+}
+
+// NewMariaDBDatabaseId returns the ID of the MariaDB Database
+func NewMariaDBDatabaseId(subscriptionId, resourceGroup, serverName, name string) MariaDBDatabaseId {
+	return MariaDBDatabaseId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParseMariaDBDatabaseId parses the ID of a MariaDB Database
+func ParseMariaDBDatabaseId(input string) (*MariaDBDatabaseId, error) {
+	values, err := mariaDBDatabaseIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MariaDBDatabaseId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the MariaDB Database
+func (id MariaDBDatabaseId) String() string {
+	return mariaDBDatabaseIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidateMariaDBDatabaseId validates that the value is the ID of a MariaDB Database
+func ValidateMariaDBDatabaseId(i interface{}, k string) (warnings []string, errors []error) {
+	return mariaDBDatabaseIdFormat.validate(i, k)
+}
+
+// MariaDBServerId is the ID of a MariaDB Server in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMariaDB/servers/{name}`
+type MariaDBServerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var mariaDBServerIdFormat = idFormat{
+	description: "MariaDB Server",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforMariaDB"},
+		{key: "servers"},
+	},
+}
+
+// NewMariaDBServerId returns the ID of the MariaDB Server
+func NewMariaDBServerId(subscriptionId, resourceGroup, name string) MariaDBServerId {
+	return MariaDBServerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseMariaDBServerId parses the ID of a MariaDB Server
+func ParseMariaDBServerId(input string) (*MariaDBServerId, error) {
+	values, err := mariaDBServerIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MariaDBServerId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the MariaDB Server
+func (id MariaDBServerId) String() string {
+	return mariaDBServerIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateMariaDBServerId validates that the value is the ID of a MariaDB Server
+func ValidateMariaDBServerId(i interface{}, k string) (warnings []string, errors []error) {
+	return mariaDBServerIdFormat.validate(i, k)
+}
+
+// MediaServicesAccountId is the ID of a Media Services Account in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Media/mediaservices/{name}`
+type MediaServicesAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var mediaServicesAccountIdFormat = idFormat{
+	description: "Media Services Account",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Media"},
+		{key: "mediaservices"},
+	},
+}
+
+// NewMediaServicesAccountId returns the ID of the Media Services Account
+func NewMediaServicesAccountId(subscriptionId, resourceGroup, name string) MediaServicesAccountId {
+	return MediaServicesAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseMediaServicesAccountId parses the ID of a Media Services Account
+func ParseMediaServicesAccountId(input string) (*MediaServicesAccountId, error) {
+	values, err := mediaServicesAccountIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MediaServicesAccountId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Media Services Account
+func (id MediaServicesAccountId) String() string {
+	return mediaServicesAccountIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateMediaServicesAccountId validates that the value is the ID of a Media Services Account
+func ValidateMediaServicesAccountId(i interface{}, k string) (warnings []string, errors []error) {
+	return mediaServicesAccountIdFormat.validate(i, k)
+}
+
+// MonitorActionGroupId is the ID of an Action Group in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/actionGroups/{name}`
+type MonitorActionGroupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var monitorActionGroupIdFormat = idFormat{
+	description: "Action Group",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Insights"},
+		{key: "actionGroups"},
+	},
+}
+
+// NewMonitorActionGroupId returns the ID of the Action Group
+func NewMonitorActionGroupId(subscriptionId, resourceGroup, name string) MonitorActionGroupId {
+	return MonitorActionGroupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseMonitorActionGroupId parses the ID of an Action Group
+func ParseMonitorActionGroupId(input string) (*MonitorActionGroupId, error) {
+	values, err := monitorActionGroupIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorActionGroupId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Action Group
+func (id MonitorActionGroupId) String() string {
+	return monitorActionGroupIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateMonitorActionGroupId validates that the value is the ID of an Action Group
+func ValidateMonitorActionGroupId(i interface{}, k string) (warnings []string, errors []error) {
+	return monitorActionGroupIdFormat.validate(i, k)
+}
+
+// MonitorActivityLogAlertId is the ID of an Activity Log Alert in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/activityLogAlerts/{name}`
+type MonitorActivityLogAlertId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var monitorActivityLogAlertIdFormat = idFormat{
+	description: "Activity Log Alert",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Insights"},
+		{key: "activityLogAlerts"},
+	},
+}
+
+// NewMonitorActivityLogAlertId returns the ID of the Activity Log Alert
+func NewMonitorActivityLogAlertId(subscriptionId, resourceGroup, name string) MonitorActivityLogAlertId {
+	return MonitorActivityLogAlertId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseMonitorActivityLogAlertId parses the ID of an Activity Log Alert
+func ParseMonitorActivityLogAlertId(input string) (*MonitorActivityLogAlertId, error) {
+	values, err := monitorActivityLogAlertIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorActivityLogAlertId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Activity Log Alert
+func (id MonitorActivityLogAlertId) String() string {
+	return monitorActivityLogAlertIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateMonitorActivityLogAlertId validates that the value is the ID of an Activity Log Alert
+func ValidateMonitorActivityLogAlertId(i interface{}, k string) (warnings []string, errors []error) {
+	return monitorActivityLogAlertIdFormat.validate(i, k)
+}
+
+// MonitorAutoscaleSettingId is the ID of an Autoscale Setting in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/autoscaleSettings/{name}`
+type MonitorAutoscaleSettingId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var monitorAutoscaleSettingIdFormat = idFormat{
+	description: "Autoscale Setting",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Insights"},
+		{key: "autoscaleSettings"},
+	},
+}
+
+// NewMonitorAutoscaleSettingId returns the ID of the Autoscale Setting
+func NewMonitorAutoscaleSettingId(subscriptionId, resourceGroup, name string) MonitorAutoscaleSettingId {
+	return MonitorAutoscaleSettingId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseMonitorAutoscaleSettingId parses the ID of an Autoscale Setting
+func ParseMonitorAutoscaleSettingId(input string) (*MonitorAutoscaleSettingId, error) {
+	values, err := monitorAutoscaleSettingIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorAutoscaleSettingId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Autoscale Setting
+func (id MonitorAutoscaleSettingId) String() string {
+	return monitorAutoscaleSettingIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateMonitorAutoscaleSettingId validates that the value is the ID of an Autoscale Setting
+func ValidateMonitorAutoscaleSettingId(i interface{}, k string) (warnings []string, errors []error) {
+	return monitorAutoscaleSettingIdFormat.validate(i, k)
+}
+
+// MonitorMetricAlertId is the ID of a Metric Alert in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/metricAlerts/{name}`
+type MonitorMetricAlertId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var monitorMetricAlertIdFormat = idFormat{
+	description: "Metric Alert",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Insights"},
+		{key: "metricAlerts"},
+	},
+}
+
+// NewMonitorMetricAlertId returns the ID of the Metric Alert
+func NewMonitorMetricAlertId(subscriptionId, resourceGroup, name string) MonitorMetricAlertId {
+	return MonitorMetricAlertId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseMonitorMetricAlertId parses the ID of a Metric Alert
+func ParseMonitorMetricAlertId(input string) (*MonitorMetricAlertId, error) {
+	values, err := monitorMetricAlertIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorMetricAlertId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Metric Alert
+func (id MonitorMetricAlertId) String() string {
+	return monitorMetricAlertIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateMonitorMetricAlertId validates that the value is the ID of a Metric Alert
+func ValidateMonitorMetricAlertId(i interface{}, k string) (warnings []string, errors []error) {
+	return monitorMetricAlertIdFormat.validate(i, k)
+}
+
+// MonitorMetricAlertRuleId is the ID of a Metric Alert Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/alertRules/{name}`
+type MonitorMetricAlertRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var monitorMetricAlertRuleIdFormat = idFormat{
+	description: "Metric Alert Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Insights"},
+		{key: "alertRules"},
+	},
+}
+
+// NewMonitorMetricAlertRuleId returns the ID of the Metric Alert Rule
+func NewMonitorMetricAlertRuleId(subscriptionId, resourceGroup, name string) MonitorMetricAlertRuleId {
+	return MonitorMetricAlertRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseMonitorMetricAlertRuleId parses the ID of a Metric Alert Rule
+func ParseMonitorMetricAlertRuleId(input string) (*MonitorMetricAlertRuleId, error) {
+	values, err := monitorMetricAlertRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorMetricAlertRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Metric Alert Rule
+func (id MonitorMetricAlertRuleId) String() string {
+	return monitorMetricAlertRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateMonitorMetricAlertRuleId validates that the value is the ID of a Metric Alert Rule
+func ValidateMonitorMetricAlertRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return monitorMetricAlertRuleIdFormat.validate(i, k)
+}
+
+// MySQLConfigurationId is the ID of a MySQL Configuration in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/configurations/{name}`
+type MySQLConfigurationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var mySQLConfigurationIdFormat = idFormat{
+	description: "MySQL Configuration",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforMySQL"},
+		{key: "servers"},
+		{key: "configurations"},
+	},
+}
+
+// NewMySQLConfigurationId returns the ID of the MySQL Configuration
+func NewMySQLConfigurationId(subscriptionId, resourceGroup, serverName, name string) MySQLConfigurationId {
+	return MySQLConfigurationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParseMySQLConfigurationId parses the ID of a MySQL Configuration
+func ParseMySQLConfigurationId(input string) (*MySQLConfigurationId, error) {
+	values, err := mySQLConfigurationIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySQLConfigurationId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the MySQL Configuration
+func (id MySQLConfigurationId) String() string {
+	return mySQLConfigurationIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidateMySQLConfigurationId validates that the value is the ID of a MySQL Configuration
+func ValidateMySQLConfigurationId(i interface{}, k string) (warnings []string, errors []error) {
+	return mySQLConfigurationIdFormat.validate(i, k)
+}
+
+// MySQLDatabaseId is the ID of a MySQL Database in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/databases/{name}`
+type MySQLDatabaseId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var mySQLDatabaseIdFormat = idFormat{
+	description: "MySQL Database",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforMySQL"},
+		{key: "servers"},
+		{key: "databases"},
+	},
+}
+
+// NewMySQLDatabaseId returns the ID of the MySQL Database
+func NewMySQLDatabaseId(subscriptionId, resourceGroup, serverName, name string) MySQLDatabaseId {
+	return MySQLDatabaseId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParseMySQLDatabaseId parses the ID of a MySQL Database
+func ParseMySQLDatabaseId(input string) (*MySQLDatabaseId, error) {
+	values, err := mySQLDatabaseIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySQLDatabaseId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the MySQL Database
+func (id MySQLDatabaseId) String() string {
+	return mySQLDatabaseIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidateMySQLDatabaseId validates that the value is the ID of a MySQL Database
+func ValidateMySQLDatabaseId(i interface{}, k string) (warnings []string, errors []error) {
+	return mySQLDatabaseIdFormat.validate(i, k)
+}
+
+// MySQLFirewallRuleId is the ID of a MySQL Firewall Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/firewallRules/{name}`
+type MySQLFirewallRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var mySQLFirewallRuleIdFormat = idFormat{
+	description: "MySQL Firewall Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforMySQL"},
+		{key: "servers"},
+		{key: "firewallRules"},
+	},
+}
+
+// NewMySQLFirewallRuleId returns the ID of the MySQL Firewall Rule
+func NewMySQLFirewallRuleId(subscriptionId, resourceGroup, serverName, name string) MySQLFirewallRuleId {
+	return MySQLFirewallRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParseMySQLFirewallRuleId parses the ID of a MySQL Firewall Rule
+func ParseMySQLFirewallRuleId(input string) (*MySQLFirewallRuleId, error) {
+	values, err := mySQLFirewallRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySQLFirewallRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the MySQL Firewall Rule
+func (id MySQLFirewallRuleId) String() string {
+	return mySQLFirewallRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidateMySQLFirewallRuleId validates that the value is the ID of a MySQL Firewall Rule
+func ValidateMySQLFirewallRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return mySQLFirewallRuleIdFormat.validate(i, k)
+}
+
+// MySQLServerId is the ID of a MySQL Server in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{name}`
+type MySQLServerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var mySQLServerIdFormat = idFormat{
+	description: "MySQL Server",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforMySQL"},
+		{key: "servers"},
+	},
+}
+
+// NewMySQLServerId returns the ID of the MySQL Server
+func NewMySQLServerId(subscriptionId, resourceGroup, name string) MySQLServerId {
+	return MySQLServerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseMySQLServerId parses the ID of a MySQL Server
+func ParseMySQLServerId(input string) (*MySQLServerId, error) {
+	values, err := mySQLServerIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySQLServerId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the MySQL Server
+func (id MySQLServerId) String() string {
+	return mySQLServerIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateMySQLServerId validates that the value is the ID of a MySQL Server
+func ValidateMySQLServerId(i interface{}, k string) (warnings []string, errors []error) {
+	return mySQLServerIdFormat.validate(i, k)
+}
+
+// MySQLVirtualNetworkRuleId is the ID of a MySQL Virtual Network Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{serverName}/virtualNetworkRules/{name}`
+type MySQLVirtualNetworkRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var mySQLVirtualNetworkRuleIdFormat = idFormat{
+	description: "MySQL Virtual Network Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforMySQL"},
+		{key: "servers"},
+		{key: "virtualNetworkRules"},
+	},
+}
+
+// NewMySQLVirtualNetworkRuleId returns the ID of the MySQL Virtual Network Rule
+func NewMySQLVirtualNetworkRuleId(subscriptionId, resourceGroup, serverName, name string) MySQLVirtualNetworkRuleId {
+	return MySQLVirtualNetworkRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParseMySQLVirtualNetworkRuleId parses the ID of a MySQL Virtual Network Rule
+func ParseMySQLVirtualNetworkRuleId(input string) (*MySQLVirtualNetworkRuleId, error) {
+	values, err := mySQLVirtualNetworkRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MySQLVirtualNetworkRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the MySQL Virtual Network Rule
+func (id MySQLVirtualNetworkRuleId) String() string {
+	return mySQLVirtualNetworkRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidateMySQLVirtualNetworkRuleId validates that the value is the ID of a MySQL Virtual Network Rule
+func ValidateMySQLVirtualNetworkRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return mySQLVirtualNetworkRuleIdFormat.validate(i, k)
+}
+
+// NetworkInterfaceIPConfigurationId is the ID of a Network Interface IP Configuration in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{networkInterfaceName}/ipConfigurations/{name}`
+type NetworkInterfaceIPConfigurationId struct {
+	SubscriptionId       string
+	ResourceGroup        string
+	NetworkInterfaceName string
+	Name                 string
+}
+
+var networkInterfaceIPConfigurationIdFormat = idFormat{
+	description: "Network Interface IP Configuration",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "networkInterfaces"},
+		{key: "ipConfigurations"},
+	},
+}
+
+// NewNetworkInterfaceIPConfigurationId returns the ID of the Network Interface IP Configuration
+func NewNetworkInterfaceIPConfigurationId(subscriptionId, resourceGroup, networkInterfaceName, name string) NetworkInterfaceIPConfigurationId {
+	return NetworkInterfaceIPConfigurationId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		NetworkInterfaceName: networkInterfaceName,
+		Name:                 name,
+	}
+}
+
+// ParseNetworkInterfaceIPConfigurationId parses the ID of a Network Interface IP Configuration
+func ParseNetworkInterfaceIPConfigurationId(input string) (*NetworkInterfaceIPConfigurationId, error) {
+	values, err := networkInterfaceIPConfigurationIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkInterfaceIPConfigurationId{
+		SubscriptionId:       values[0],
+		ResourceGroup:        values[1],
+		NetworkInterfaceName: values[2],
+		Name:                 values[3],
+	}, nil
+}
+
+// String returns the ID of the Network Interface IP Configuration
+func (id NetworkInterfaceIPConfigurationId) String() string {
+	return networkInterfaceIPConfigurationIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName, id.Name)
+}
+
+// ValidateNetworkInterfaceIPConfigurationId validates that the value is the ID of a Network Interface IP Configuration
+func ValidateNetworkInterfaceIPConfigurationId(i interface{}, k string) (warnings []string, errors []error) {
+	return networkInterfaceIPConfigurationIdFormat.validate(i, k)
+}
+
+// NetworkInterfaceId is the ID of a Network Interface in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{name}`
+type NetworkInterfaceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var networkInterfaceIdFormat = idFormat{
+	description: "Network Interface",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "networkInterfaces"},
+	},
+}
+
+// NewNetworkInterfaceId returns the ID of the Network Interface
+func NewNetworkInterfaceId(subscriptionId, resourceGroup, name string) NetworkInterfaceId {
+	return NetworkInterfaceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseNetworkInterfaceId parses the ID of a Network Interface
+func ParseNetworkInterfaceId(input string) (*NetworkInterfaceId, error) {
+	values, err := networkInterfaceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkInterfaceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Network Interface
+func (id NetworkInterfaceId) String() string {
+	return networkInterfaceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateNetworkInterfaceId validates that the value is the ID of a Network Interface
+func ValidateNetworkInterfaceId(i interface{}, k string) (warnings []string, errors []error) {
+	return networkInterfaceIdFormat.validate(i, k)
+}
+
+// NetworkSecurityGroupId is the ID of a Network Security Group in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{name}`
+type NetworkSecurityGroupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var networkSecurityGroupIdFormat = idFormat{
+	description: "Network Security Group",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "networkSecurityGroups"},
+	},
+}
+
+// NewNetworkSecurityGroupId returns the ID of the Network Security Group
+func NewNetworkSecurityGroupId(subscriptionId, resourceGroup, name string) NetworkSecurityGroupId {
+	return NetworkSecurityGroupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseNetworkSecurityGroupId parses the ID of a Network Security Group
+func ParseNetworkSecurityGroupId(input string) (*NetworkSecurityGroupId, error) {
+	values, err := networkSecurityGroupIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkSecurityGroupId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Network Security Group
+func (id NetworkSecurityGroupId) String() string {
+	return networkSecurityGroupIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateNetworkSecurityGroupId validates that the value is the ID of a Network Security Group
+func ValidateNetworkSecurityGroupId(i interface{}, k string) (warnings []string, errors []error) {
+	return networkSecurityGroupIdFormat.validate(i, k)
+}
+
+// NetworkSecurityRuleId is the ID of a Network Security Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroupName}/securityRules/{name}`
+type NetworkSecurityRuleId struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	NetworkSecurityGroupName string
+	Name                     string
+}
+
+var networkSecurityRuleIdFormat = idFormat{
+	description: "Network Security Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "networkSecurityGroups"},
+		{key: "securityRules"},
+	},
+}
+
+// NewNetworkSecurityRuleId returns the ID of the Network Security Rule
+func NewNetworkSecurityRuleId(subscriptionId, resourceGroup, networkSecurityGroupName, name string) NetworkSecurityRuleId {
+	return NetworkSecurityRuleId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		NetworkSecurityGroupName: networkSecurityGroupName,
+		Name:                     name,
+	}
+}
+
+// ParseNetworkSecurityRuleId parses the ID of a Network Security Rule
+func ParseNetworkSecurityRuleId(input string) (*NetworkSecurityRuleId, error) {
+	values, err := networkSecurityRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkSecurityRuleId{
+		SubscriptionId:           values[0],
+		ResourceGroup:            values[1],
+		NetworkSecurityGroupName: values[2],
+		Name:                     values[3],
+	}, nil
+}
+
+// String returns the ID of the Network Security Rule
+func (id NetworkSecurityRuleId) String() string {
+	return networkSecurityRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
+}
+
+// ValidateNetworkSecurityRuleId validates that the value is the ID of a Network Security Rule
+func ValidateNetworkSecurityRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return networkSecurityRuleIdFormat.validate(i, k)
+}
+
+// NetworkWatcherId is the ID of a Network Watcher in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{name}`
+type NetworkWatcherId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var networkWatcherIdFormat = idFormat{
+	description: "Network Watcher",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "networkWatchers"},
+	},
+}
+
+// NewNetworkWatcherId returns the ID of the Network Watcher
+func NewNetworkWatcherId(subscriptionId, resourceGroup, name string) NetworkWatcherId {
+	return NetworkWatcherId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseNetworkWatcherId parses the ID of a Network Watcher
+func ParseNetworkWatcherId(input string) (*NetworkWatcherId, error) {
+	values, err := networkWatcherIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkWatcherId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Network Watcher
+func (id NetworkWatcherId) String() string {
+	return networkWatcherIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateNetworkWatcherId validates that the value is the ID of a Network Watcher
+func ValidateNetworkWatcherId(i interface{}, k string) (warnings []string, errors []error) {
+	return networkWatcherIdFormat.validate(i, k)
+}
+
+// NotificationHubId is the ID of a Notification Hub in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespaceName}/notificationHubs/{name}`
+type NotificationHubId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+var notificationHubIdFormat = idFormat{
+	description: "Notification Hub",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.NotificationHubs"},
+		{key: "namespaces"},
+		{key: "notificationHubs"},
+	},
+}
+
+// NewNotificationHubId returns the ID of the Notification Hub
+func NewNotificationHubId(subscriptionId, resourceGroup, namespaceName, name string) NotificationHubId {
+	return NotificationHubId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ParseNotificationHubId parses the ID of a Notification Hub
+func ParseNotificationHubId(input string) (*NotificationHubId, error) {
+	values, err := notificationHubIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationHubId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Notification Hub
+func (id NotificationHubId) String() string {
+	return notificationHubIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ValidateNotificationHubId validates that the value is the ID of a Notification Hub
+func ValidateNotificationHubId(i interface{}, k string) (warnings []string, errors []error) {
+	return notificationHubIdFormat.validate(i, k)
+}
+
+// NotificationHubNamespaceId is the ID of a Notification Hub Namespace in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{name}`
+type NotificationHubNamespaceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var notificationHubNamespaceIdFormat = idFormat{
+	description: "Notification Hub Namespace",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.NotificationHubs"},
+		{key: "namespaces"},
+	},
+}
+
+// NewNotificationHubNamespaceId returns the ID of the Notification Hub Namespace
+func NewNotificationHubNamespaceId(subscriptionId, resourceGroup, name string) NotificationHubNamespaceId {
+	return NotificationHubNamespaceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseNotificationHubNamespaceId parses the ID of a Notification Hub Namespace
+func ParseNotificationHubNamespaceId(input string) (*NotificationHubNamespaceId, error) {
+	values, err := notificationHubNamespaceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationHubNamespaceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Notification Hub Namespace
+func (id NotificationHubNamespaceId) String() string {
+	return notificationHubNamespaceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateNotificationHubNamespaceId validates that the value is the ID of a Notification Hub Namespace
+func ValidateNotificationHubNamespaceId(i interface{}, k string) (warnings []string, errors []error) {
+	return notificationHubNamespaceIdFormat.validate(i, k)
+}
+
+// PacketCaptureId is the ID of a Packet Capture in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcherName}/packetCaptures/{name}`
+type PacketCaptureId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkWatcherName string
+	Name               string
+}
+
+var packetCaptureIdFormat = idFormat{
+	description: "Packet Capture",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "networkWatchers"},
+		{key: "packetCaptures"},
+	},
+}
+
+// NewPacketCaptureId returns the ID of the Packet Capture
+func NewPacketCaptureId(subscriptionId, resourceGroup, networkWatcherName, name string) PacketCaptureId {
+	return PacketCaptureId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkWatcherName: networkWatcherName,
+		Name:               name,
+	}
+}
+
+// ParsePacketCaptureId parses the ID of a Packet Capture
+func ParsePacketCaptureId(input string) (*PacketCaptureId, error) {
+	values, err := packetCaptureIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PacketCaptureId{
+		SubscriptionId:     values[0],
+		ResourceGroup:      values[1],
+		NetworkWatcherName: values[2],
+		Name:               values[3],
+	}, nil
+}
+
+// String returns the ID of the Packet Capture
+func (id PacketCaptureId) String() string {
+	return packetCaptureIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
+}
+
+// ValidatePacketCaptureId validates that the value is the ID of a Packet Capture
+func ValidatePacketCaptureId(i interface{}, k string) (warnings []string, errors []error) {
+	return packetCaptureIdFormat.validate(i, k)
+}
+
+// PostgreSQLConfigurationId is the ID of a PostgreSQL Configuration in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/configurations/{name}`
+type PostgreSQLConfigurationId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var postgreSQLConfigurationIdFormat = idFormat{
+	description: "PostgreSQL Configuration",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforPostgreSQL"},
+		{key: "servers"},
+		{key: "configurations"},
+	},
+}
+
+// NewPostgreSQLConfigurationId returns the ID of the PostgreSQL Configuration
+func NewPostgreSQLConfigurationId(subscriptionId, resourceGroup, serverName, name string) PostgreSQLConfigurationId {
+	return PostgreSQLConfigurationId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParsePostgreSQLConfigurationId parses the ID of a PostgreSQL Configuration
+func ParsePostgreSQLConfigurationId(input string) (*PostgreSQLConfigurationId, error) {
+	values, err := postgreSQLConfigurationIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLConfigurationId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the PostgreSQL Configuration
+func (id PostgreSQLConfigurationId) String() string {
+	return postgreSQLConfigurationIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidatePostgreSQLConfigurationId validates that the value is the ID of a PostgreSQL Configuration
+func ValidatePostgreSQLConfigurationId(i interface{}, k string) (warnings []string, errors []error) {
+	return postgreSQLConfigurationIdFormat.validate(i, k)
+}
+
+// PostgreSQLDatabaseId is the ID of a PostgreSQL Database in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/databases/{name}`
+type PostgreSQLDatabaseId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var postgreSQLDatabaseIdFormat = idFormat{
+	description: "PostgreSQL Database",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforPostgreSQL"},
+		{key: "servers"},
+		{key: "databases"},
+	},
+}
+
+// NewPostgreSQLDatabaseId returns the ID of the PostgreSQL Database
+func NewPostgreSQLDatabaseId(subscriptionId, resourceGroup, serverName, name string) PostgreSQLDatabaseId {
+	return PostgreSQLDatabaseId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParsePostgreSQLDatabaseId parses the ID of a PostgreSQL Database
+func ParsePostgreSQLDatabaseId(input string) (*PostgreSQLDatabaseId, error) {
+	values, err := postgreSQLDatabaseIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLDatabaseId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the PostgreSQL Database
+func (id PostgreSQLDatabaseId) String() string {
+	return postgreSQLDatabaseIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidatePostgreSQLDatabaseId validates that the value is the ID of a PostgreSQL Database
+func ValidatePostgreSQLDatabaseId(i interface{}, k string) (warnings []string, errors []error) {
+	return postgreSQLDatabaseIdFormat.validate(i, k)
+}
+
+// PostgreSQLFirewallRuleId is the ID of a PostgreSQL Firewall Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/firewallRules/{name}`
+type PostgreSQLFirewallRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var postgreSQLFirewallRuleIdFormat = idFormat{
+	description: "PostgreSQL Firewall Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforPostgreSQL"},
+		{key: "servers"},
+		{key: "firewallRules"},
+	},
+}
+
+// NewPostgreSQLFirewallRuleId returns the ID of the PostgreSQL Firewall Rule
+func NewPostgreSQLFirewallRuleId(subscriptionId, resourceGroup, serverName, name string) PostgreSQLFirewallRuleId {
+	return PostgreSQLFirewallRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParsePostgreSQLFirewallRuleId parses the ID of a PostgreSQL Firewall Rule
+func ParsePostgreSQLFirewallRuleId(input string) (*PostgreSQLFirewallRuleId, error) {
+	values, err := postgreSQLFirewallRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLFirewallRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the PostgreSQL Firewall Rule
+func (id PostgreSQLFirewallRuleId) String() string {
+	return postgreSQLFirewallRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidatePostgreSQLFirewallRuleId validates that the value is the ID of a PostgreSQL Firewall Rule
+func ValidatePostgreSQLFirewallRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return postgreSQLFirewallRuleIdFormat.validate(i, k)
+}
+
+// PostgreSQLServerId is the ID of a PostgreSQL Server in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{name}`
+type PostgreSQLServerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var postgreSQLServerIdFormat = idFormat{
+	description: "PostgreSQL Server",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforPostgreSQL"},
+		{key: "servers"},
+	},
+}
+
+// NewPostgreSQLServerId returns the ID of the PostgreSQL Server
+func NewPostgreSQLServerId(subscriptionId, resourceGroup, name string) PostgreSQLServerId {
+	return PostgreSQLServerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParsePostgreSQLServerId parses the ID of a PostgreSQL Server
+func ParsePostgreSQLServerId(input string) (*PostgreSQLServerId, error) {
+	values, err := postgreSQLServerIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLServerId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the PostgreSQL Server
+func (id PostgreSQLServerId) String() string {
+	return postgreSQLServerIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidatePostgreSQLServerId validates that the value is the ID of a PostgreSQL Server
+func ValidatePostgreSQLServerId(i interface{}, k string) (warnings []string, errors []error) {
+	return postgreSQLServerIdFormat.validate(i, k)
+}
+
+// PostgreSQLVirtualNetworkRuleId is the ID of a PostgreSQL Virtual Network Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{serverName}/virtualNetworkRules/{name}`
+type PostgreSQLVirtualNetworkRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var postgreSQLVirtualNetworkRuleIdFormat = idFormat{
+	description: "PostgreSQL Virtual Network Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.DBforPostgreSQL"},
+		{key: "servers"},
+		{key: "virtualNetworkRules"},
+	},
+}
+
+// NewPostgreSQLVirtualNetworkRuleId returns the ID of the PostgreSQL Virtual Network Rule
+func NewPostgreSQLVirtualNetworkRuleId(subscriptionId, resourceGroup, serverName, name string) PostgreSQLVirtualNetworkRuleId {
+	return PostgreSQLVirtualNetworkRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParsePostgreSQLVirtualNetworkRuleId parses the ID of a PostgreSQL Virtual Network Rule
+func ParsePostgreSQLVirtualNetworkRuleId(input string) (*PostgreSQLVirtualNetworkRuleId, error) {
+	values, err := postgreSQLVirtualNetworkRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLVirtualNetworkRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the PostgreSQL Virtual Network Rule
+func (id PostgreSQLVirtualNetworkRuleId) String() string {
+	return postgreSQLVirtualNetworkRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidatePostgreSQLVirtualNetworkRuleId validates that the value is the ID of a PostgreSQL Virtual Network Rule
+func ValidatePostgreSQLVirtualNetworkRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return postgreSQLVirtualNetworkRuleIdFormat.validate(i, k)
+}
+
+// PrivateEndpointId is the ID of a Private Endpoint in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/interfaceEndpoints/{name}`
+type PrivateEndpointId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var privateEndpointIdFormat = idFormat{
+	description: "Private Endpoint",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "interfaceEndpoints"},
+	},
+}
+
+// NewPrivateEndpointId returns the ID of the Private Endpoint
+func NewPrivateEndpointId(subscriptionId, resourceGroup, name string) PrivateEndpointId {
+	return PrivateEndpointId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParsePrivateEndpointId parses the ID of a Private Endpoint
+func ParsePrivateEndpointId(input string) (*PrivateEndpointId, error) {
+	values, err := privateEndpointIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateEndpointId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Private Endpoint
+func (id PrivateEndpointId) String() string {
+	return privateEndpointIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidatePrivateEndpointId validates that the value is the ID of a Private Endpoint
+func ValidatePrivateEndpointId(i interface{}, k string) (warnings []string, errors []error) {
+	return privateEndpointIdFormat.validate(i, k)
+}
+
+// PublicIPId is the ID of a Public IP in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPAddresses/{name}`
+type PublicIPId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var publicIPIdFormat = idFormat{
+	description: "Public IP",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "publicIPAddresses"},
+	},
+}
+
+// NewPublicIPId returns the ID of the Public IP
+func NewPublicIPId(subscriptionId, resourceGroup, name string) PublicIPId {
+	return PublicIPId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParsePublicIPId parses the ID of a Public IP
+func ParsePublicIPId(input string) (*PublicIPId, error) {
+	values, err := publicIPIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicIPId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Public IP
+func (id PublicIPId) String() string {
+	return publicIPIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidatePublicIPId validates that the value is the ID of a Public IP
+func ValidatePublicIPId(i interface{}, k string) (warnings []string, errors []error) {
+	return publicIPIdFormat.validate(i, k)
+}
+
+// PublicIPPrefixId is the ID of a Public IP Prefix in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPPrefixes/{name}`
+type PublicIPPrefixId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var publicIPPrefixIdFormat = idFormat{
+	description: "Public IP Prefix",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "publicIPPrefixes"},
+	},
+}
+
+// NewPublicIPPrefixId returns the ID of the Public IP Prefix
+func NewPublicIPPrefixId(subscriptionId, resourceGroup, name string) PublicIPPrefixId {
+	return PublicIPPrefixId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParsePublicIPPrefixId parses the ID of a Public IP Prefix
+func ParsePublicIPPrefixId(input string) (*PublicIPPrefixId, error) {
+	values, err := publicIPPrefixIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicIPPrefixId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Public IP Prefix
+func (id PublicIPPrefixId) String() string {
+	return publicIPPrefixIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidatePublicIPPrefixId validates that the value is the ID of a Public IP Prefix
+func ValidatePublicIPPrefixId(i interface{}, k string) (warnings []string, errors []error) {
+	return publicIPPrefixIdFormat.validate(i, k)
+}
+
+// RecoveryServicesProtectionPolicyId is the ID of a Recovery Services Protection Policy in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{vaultName}/backupPolicies/{name}`
+type RecoveryServicesProtectionPolicyId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VaultName      string
+	Name           string
+}
+
+var recoveryServicesProtectionPolicyIdFormat = idFormat{
+	description: "Recovery Services Protection Policy",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.RecoveryServices"},
+		{key: "vaults"},
+		{key: "backupPolicies"},
+	},
+}
+
+// NewRecoveryServicesProtectionPolicyId returns the ID of the Recovery Services Protection Policy
+func NewRecoveryServicesProtectionPolicyId(subscriptionId, resourceGroup, vaultName, name string) RecoveryServicesProtectionPolicyId {
+	return RecoveryServicesProtectionPolicyId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VaultName:      vaultName,
+		Name:           name,
+	}
+}
+
+// ParseRecoveryServicesProtectionPolicyId parses the ID of a Recovery Services Protection Policy
+func ParseRecoveryServicesProtectionPolicyId(input string) (*RecoveryServicesProtectionPolicyId, error) {
+	values, err := recoveryServicesProtectionPolicyIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryServicesProtectionPolicyId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		VaultName:      values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Recovery Services Protection Policy
+func (id RecoveryServicesProtectionPolicyId) String() string {
+	return recoveryServicesProtectionPolicyIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.VaultName, id.Name)
+}
+
+// ValidateRecoveryServicesProtectionPolicyId validates that the value is the ID of a Recovery Services Protection Policy
+func ValidateRecoveryServicesProtectionPolicyId(i interface{}, k string) (warnings []string, errors []error) {
+	return recoveryServicesProtectionPolicyIdFormat.validate(i, k)
+}
+
+// RecoveryServicesVaultId is the ID of a Recovery Services Vault in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{name}`
+type RecoveryServicesVaultId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var recoveryServicesVaultIdFormat = idFormat{
+	description: "Recovery Services Vault",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.RecoveryServices"},
+		{key: "vaults"},
+	},
+}
+
+// NewRecoveryServicesVaultId returns the ID of the Recovery Services Vault
+func NewRecoveryServicesVaultId(subscriptionId, resourceGroup, name string) RecoveryServicesVaultId {
+	return RecoveryServicesVaultId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseRecoveryServicesVaultId parses the ID of a Recovery Services Vault
+func ParseRecoveryServicesVaultId(input string) (*RecoveryServicesVaultId, error) {
+	values, err := recoveryServicesVaultIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryServicesVaultId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Recovery Services Vault
+func (id RecoveryServicesVaultId) String() string {
+	return recoveryServicesVaultIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateRecoveryServicesVaultId validates that the value is the ID of a Recovery Services Vault
+func ValidateRecoveryServicesVaultId(i interface{}, k string) (warnings []string, errors []error) {
+	return recoveryServicesVaultIdFormat.validate(i, k)
+}
+
+// RedisCacheId is the ID of a Redis Cache in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{name}`
+type RedisCacheId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var redisCacheIdFormat = idFormat{
+	description: "Redis Cache",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Cache"},
+		{key: "Redis"},
+	},
+}
+
+// NewRedisCacheId returns the ID of the Redis Cache
+func NewRedisCacheId(subscriptionId, resourceGroup, name string) RedisCacheId {
+	return RedisCacheId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseRedisCacheId parses the ID of a Redis Cache
+func ParseRedisCacheId(input string) (*RedisCacheId, error) {
+	values, err := redisCacheIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisCacheId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Redis Cache
+func (id RedisCacheId) String() string {
+	return redisCacheIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateRedisCacheId validates that the value is the ID of a Redis Cache
+func ValidateRedisCacheId(i interface{}, k string) (warnings []string, errors []error) {
+	return redisCacheIdFormat.validate(i, k)
+}
+
+// RedisFirewallRuleId is the ID of a Redis Firewall Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{cacheName}/firewallRules/{name}`
+type RedisFirewallRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	CacheName      string
+	Name           string
+}
+
+var redisFirewallRuleIdFormat = idFormat{
+	description: "Redis Firewall Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Cache"},
+		{key: "Redis"},
+		{key: "firewallRules"},
+	},
+}
+
+// NewRedisFirewallRuleId returns the ID of the Redis Firewall Rule
+func NewRedisFirewallRuleId(subscriptionId, resourceGroup, cacheName, name string) RedisFirewallRuleId {
+	return RedisFirewallRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		CacheName:      cacheName,
+		Name:           name,
+	}
+}
+
+// ParseRedisFirewallRuleId parses the ID of a Redis Firewall Rule
+func ParseRedisFirewallRuleId(input string) (*RedisFirewallRuleId, error) {
+	values, err := redisFirewallRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisFirewallRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		CacheName:      values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Redis Firewall Rule
+func (id RedisFirewallRuleId) String() string {
+	return redisFirewallRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.CacheName, id.Name)
+}
+
+// ValidateRedisFirewallRuleId validates that the value is the ID of a Redis Firewall Rule
+func ValidateRedisFirewallRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return redisFirewallRuleIdFormat.validate(i, k)
+}
+
+// RelayNamespaceId is the ID of a Relay Namespace in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{name}`
+type RelayNamespaceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var relayNamespaceIdFormat = idFormat{
+	description: "Relay Namespace",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Relay"},
+		{key: "namespaces"},
+	},
+}
+
+// NewRelayNamespaceId returns the ID of the Relay Namespace
+func NewRelayNamespaceId(subscriptionId, resourceGroup, name string) RelayNamespaceId {
+	return RelayNamespaceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseRelayNamespaceId parses the ID of a Relay Namespace
+func ParseRelayNamespaceId(input string) (*RelayNamespaceId, error) {
+	values, err := relayNamespaceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RelayNamespaceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Relay Namespace
+func (id RelayNamespaceId) String() string {
+	return relayNamespaceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateRelayNamespaceId validates that the value is the ID of a Relay Namespace
+func ValidateRelayNamespaceId(i interface{}, k string) (warnings []string, errors []error) {
+	return relayNamespaceIdFormat.validate(i, k)
+}
+
+// ResourceGroupId is the ID of a Resource Group in the format `/subscriptions/{subscriptionId}/resourceGroups/{name}`
+type ResourceGroupId struct {
+	SubscriptionId string
+	Name           string
+}
+
+var resourceGroupIdFormat = idFormat{
+	description: "Resource Group",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+	},
+}
+
+// NewResourceGroupId returns the ID of the Resource Group
+func NewResourceGroupId(subscriptionId, name string) ResourceGroupId {
+	return ResourceGroupId{
+		SubscriptionId: subscriptionId,
+		Name:           name,
+	}
+}
+
+// ParseResourceGroupId parses the ID of a Resource Group
+func ParseResourceGroupId(input string) (*ResourceGroupId, error) {
+	values, err := resourceGroupIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceGroupId{
+		SubscriptionId: values[0],
+		Name:           values[1],
+	}, nil
+}
+
+// String returns the ID of the Resource Group
+func (id ResourceGroupId) String() string {
+	return resourceGroupIdFormat.format(id.SubscriptionId, id.Name)
+}
+
+// ValidateResourceGroupId validates that the value is the ID of a Resource Group
+func ValidateResourceGroupId(i interface{}, k string) (warnings []string, errors []error) {
+	return resourceGroupIdFormat.validate(i, k)
+}
+
+// RouteFilterId is the ID of a Route Filter in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeFilters/{name}`
+type RouteFilterId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var routeFilterIdFormat = idFormat{
+	description: "Route Filter",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "routeFilters"},
+	},
+}
+
+// NewRouteFilterId returns the ID of the Route Filter
+func NewRouteFilterId(subscriptionId, resourceGroup, name string) RouteFilterId {
+	return RouteFilterId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseRouteFilterId parses the ID of a Route Filter
+func ParseRouteFilterId(input string) (*RouteFilterId, error) {
+	values, err := routeFilterIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteFilterId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Route Filter
+func (id RouteFilterId) String() string {
+	return routeFilterIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateRouteFilterId validates that the value is the ID of a Route Filter
+func ValidateRouteFilterId(i interface{}, k string) (warnings []string, errors []error) {
+	return routeFilterIdFormat.validate(i, k)
+}
+
+// RouteId is the ID of a Route in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{routeTableName}/routes/{name}`
+type RouteId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	RouteTableName string
+	Name           string
+}
+
+var routeIdFormat = idFormat{
+	description: "Route",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "routeTables"},
+		{key: "routes"},
+	},
+}
+
+// NewRouteId returns the ID of the Route
+func NewRouteId(subscriptionId, resourceGroup, routeTableName, name string) RouteId {
+	return RouteId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		RouteTableName: routeTableName,
+		Name:           name,
+	}
+}
+
+// ParseRouteId parses the ID of a Route
+func ParseRouteId(input string) (*RouteId, error) {
+	values, err := routeIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		RouteTableName: values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Route
+func (id RouteId) String() string {
+	return routeIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.RouteTableName, id.Name)
+}
+
+// ValidateRouteId validates that the value is the ID of a Route
+func ValidateRouteId(i interface{}, k string) (warnings []string, errors []error) {
+	return routeIdFormat.validate(i, k)
+}
+
+// RouteTableId is the ID of a Route Table in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{name}`
+type RouteTableId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var routeTableIdFormat = idFormat{
+	description: "Route Table",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "routeTables"},
+	},
+}
+
+// NewRouteTableId returns the ID of the Route Table
+func NewRouteTableId(subscriptionId, resourceGroup, name string) RouteTableId {
+	return RouteTableId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseRouteTableId parses the ID of a Route Table
+func ParseRouteTableId(input string) (*RouteTableId, error) {
+	values, err := routeTableIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteTableId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Route Table
+func (id RouteTableId) String() string {
+	return routeTableIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateRouteTableId validates that the value is the ID of a Route Table
+func ValidateRouteTableId(i interface{}, k string) (warnings []string, errors []error) {
+	return routeTableIdFormat.validate(i, k)
+}
+
+// SchedulerJobCollectionId is the ID of a Scheduler Job Collection in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{name}`
+type SchedulerJobCollectionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var schedulerJobCollectionIdFormat = idFormat{
+	description: "Scheduler Job Collection",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Scheduler"},
+		{key: "jobCollections"},
+	},
+}
+
+// NewSchedulerJobCollectionId returns the ID of the Scheduler Job Collection
+func NewSchedulerJobCollectionId(subscriptionId, resourceGroup, name string) SchedulerJobCollectionId {
+	return SchedulerJobCollectionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseSchedulerJobCollectionId parses the ID of a Scheduler Job Collection
+func ParseSchedulerJobCollectionId(input string) (*SchedulerJobCollectionId, error) {
+	values, err := schedulerJobCollectionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchedulerJobCollectionId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Scheduler Job Collection
+func (id SchedulerJobCollectionId) String() string {
+	return schedulerJobCollectionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateSchedulerJobCollectionId validates that the value is the ID of a Scheduler Job Collection
+func ValidateSchedulerJobCollectionId(i interface{}, k string) (warnings []string, errors []error) {
+	return schedulerJobCollectionIdFormat.validate(i, k)
+}
+
+// SchedulerJobId is the ID of a Scheduler Job in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{jobCollectionName}/jobs/{name}`
+type SchedulerJobId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	JobCollectionName string
+	Name              string
+}
+
+var schedulerJobIdFormat = idFormat{
+	description: "Scheduler Job",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Scheduler"},
+		{key: "jobCollections"},
+		{key: "jobs"},
+	},
+}
+
+// NewSchedulerJobId returns the ID of the Scheduler Job
+func NewSchedulerJobId(subscriptionId, resourceGroup, jobCollectionName, name string) SchedulerJobId {
+	return SchedulerJobId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		JobCollectionName: jobCollectionName,
+		Name:              name,
+	}
+}
+
+// ParseSchedulerJobId parses the ID of a Scheduler Job
+func ParseSchedulerJobId(input string) (*SchedulerJobId, error) {
+	values, err := schedulerJobIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SchedulerJobId{
+		SubscriptionId:    values[0],
+		ResourceGroup:     values[1],
+		JobCollectionName: values[2],
+		Name:              values[3],
+	}, nil
+}
+
+// String returns the ID of the Scheduler Job
+func (id SchedulerJobId) String() string {
+	return schedulerJobIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.JobCollectionName, id.Name)
+}
+
+// ValidateSchedulerJobId validates that the value is the ID of a Scheduler Job
+func ValidateSchedulerJobId(i interface{}, k string) (warnings []string, errors []error) {
+	return schedulerJobIdFormat.validate(i, k)
+}
+
+// SearchServiceId is the ID of a Search Service in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Search/searchServices/{name}`
+type SearchServiceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var searchServiceIdFormat = idFormat{
+	description: "Search Service",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Search"},
+		{key: "searchServices"},
+	},
+}
+
+// NewSearchServiceId returns the ID of the Search Service
+func NewSearchServiceId(subscriptionId, resourceGroup, name string) SearchServiceId {
+	return SearchServiceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseSearchServiceId parses the ID of a Search Service
+func ParseSearchServiceId(input string) (*SearchServiceId, error) {
+	values, err := searchServiceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchServiceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Search Service
+func (id SearchServiceId) String() string {
+	return searchServiceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateSearchServiceId validates that the value is the ID of a Search Service
+func ValidateSearchServiceId(i interface{}, k string) (warnings []string, errors []error) {
+	return searchServiceIdFormat.validate(i, k)
+}
+
+// ServiceBusNamespaceAuthorizationRuleId is the ID of a ServiceBus Namespace Authorization Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/authorizationRules/{name}`
+type ServiceBusNamespaceAuthorizationRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+var serviceBusNamespaceAuthorizationRuleIdFormat = idFormat{
+	description: "ServiceBus Namespace Authorization Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ServiceBus"},
+		{key: "namespaces"},
+		{key: "authorizationRules"},
+	},
+}
+
+// NewServiceBusNamespaceAuthorizationRuleId returns the ID of the ServiceBus Namespace Authorization Rule
+func NewServiceBusNamespaceAuthorizationRuleId(subscriptionId, resourceGroup, namespaceName, name string) ServiceBusNamespaceAuthorizationRuleId {
+	return ServiceBusNamespaceAuthorizationRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ParseServiceBusNamespaceAuthorizationRuleId parses the ID of a ServiceBus Namespace Authorization Rule
+func ParseServiceBusNamespaceAuthorizationRuleId(input string) (*ServiceBusNamespaceAuthorizationRuleId, error) {
+	values, err := serviceBusNamespaceAuthorizationRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusNamespaceAuthorizationRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the ServiceBus Namespace Authorization Rule
+func (id ServiceBusNamespaceAuthorizationRuleId) String() string {
+	return serviceBusNamespaceAuthorizationRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ValidateServiceBusNamespaceAuthorizationRuleId validates that the value is the ID of a ServiceBus Namespace Authorization Rule
+func ValidateServiceBusNamespaceAuthorizationRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return serviceBusNamespaceAuthorizationRuleIdFormat.validate(i, k)
+}
+
+// ServiceBusNamespaceId is the ID of a ServiceBus Namespace in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{name}`
+type ServiceBusNamespaceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var serviceBusNamespaceIdFormat = idFormat{
+	description: "ServiceBus Namespace",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ServiceBus"},
+		{key: "namespaces"},
+	},
+}
+
+// NewServiceBusNamespaceId returns the ID of the ServiceBus Namespace
+func NewServiceBusNamespaceId(subscriptionId, resourceGroup, name string) ServiceBusNamespaceId {
+	return ServiceBusNamespaceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseServiceBusNamespaceId parses the ID of a ServiceBus Namespace
+func ParseServiceBusNamespaceId(input string) (*ServiceBusNamespaceId, error) {
+	values, err := serviceBusNamespaceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusNamespaceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the ServiceBus Namespace
+func (id ServiceBusNamespaceId) String() string {
+	return serviceBusNamespaceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateServiceBusNamespaceId validates that the value is the ID of a ServiceBus Namespace
+func ValidateServiceBusNamespaceId(i interface{}, k string) (warnings []string, errors []error) {
+	return serviceBusNamespaceIdFormat.validate(i, k)
+}
+
+// ServiceBusQueueAuthorizationRuleId is the ID of a ServiceBus Queue Authorization Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{queueName}/authorizationRules/{name}`
+type ServiceBusQueueAuthorizationRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	QueueName      string
+	Name           string
+}
+
+var serviceBusQueueAuthorizationRuleIdFormat = idFormat{
+	description: "ServiceBus Queue Authorization Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ServiceBus"},
+		{key: "namespaces"},
+		{key: "queues"},
+		{key: "authorizationRules"},
+	},
+}
+
+// NewServiceBusQueueAuthorizationRuleId returns the ID of the ServiceBus Queue Authorization Rule
+func NewServiceBusQueueAuthorizationRuleId(subscriptionId, resourceGroup, namespaceName, queueName, name string) ServiceBusQueueAuthorizationRuleId {
+	return ServiceBusQueueAuthorizationRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		QueueName:      queueName,
+		Name:           name,
+	}
+}
+
+// ParseServiceBusQueueAuthorizationRuleId parses the ID of a ServiceBus Queue Authorization Rule
+func ParseServiceBusQueueAuthorizationRuleId(input string) (*ServiceBusQueueAuthorizationRuleId, error) {
+	values, err := serviceBusQueueAuthorizationRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusQueueAuthorizationRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		QueueName:      values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// String returns the ID of the ServiceBus Queue Authorization Rule
+func (id ServiceBusQueueAuthorizationRuleId) String() string {
+	return serviceBusQueueAuthorizationRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.QueueName, id.Name)
+}
+
+// ValidateServiceBusQueueAuthorizationRuleId validates that the value is the ID of a ServiceBus Queue Authorization Rule
+func ValidateServiceBusQueueAuthorizationRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return serviceBusQueueAuthorizationRuleIdFormat.validate(i, k)
+}
+
+// ServiceBusQueueId is the ID of a ServiceBus Queue in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{name}`
+type ServiceBusQueueId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+var serviceBusQueueIdFormat = idFormat{
+	description: "ServiceBus Queue",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ServiceBus"},
+		{key: "namespaces"},
+		{key: "queues"},
+	},
+}
+
+// NewServiceBusQueueId returns the ID of the ServiceBus Queue
+func NewServiceBusQueueId(subscriptionId, resourceGroup, namespaceName, name string) ServiceBusQueueId {
+	return ServiceBusQueueId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ParseServiceBusQueueId parses the ID of a ServiceBus Queue
+func ParseServiceBusQueueId(input string) (*ServiceBusQueueId, error) {
+	values, err := serviceBusQueueIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusQueueId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the ServiceBus Queue
+func (id ServiceBusQueueId) String() string {
+	return serviceBusQueueIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ValidateServiceBusQueueId validates that the value is the ID of a ServiceBus Queue
+func ValidateServiceBusQueueId(i interface{}, k string) (warnings []string, errors []error) {
+	return serviceBusQueueIdFormat.validate(i, k)
+}
+
+// ServiceBusSubscriptionId is the ID of a ServiceBus Subscription in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{name}`
+type ServiceBusSubscriptionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	TopicName      string
+	Name           string
+}
+
+var serviceBusSubscriptionIdFormat = idFormat{
+	description: "ServiceBus Subscription",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ServiceBus"},
+		{key: "namespaces"},
+		{key: "topics"},
+		{key: "subscriptions"},
+	},
+}
+
+// NewServiceBusSubscriptionId returns the ID of the ServiceBus Subscription
+func NewServiceBusSubscriptionId(subscriptionId, resourceGroup, namespaceName, topicName, name string) ServiceBusSubscriptionId {
+	return ServiceBusSubscriptionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		TopicName:      topicName,
+		Name:           name,
+	}
+}
+
+// ParseServiceBusSubscriptionId parses the ID of a ServiceBus Subscription
+func ParseServiceBusSubscriptionId(input string) (*ServiceBusSubscriptionId, error) {
+	values, err := serviceBusSubscriptionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusSubscriptionId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		TopicName:      values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// String returns the ID of the ServiceBus Subscription
+func (id ServiceBusSubscriptionId) String() string {
+	return serviceBusSubscriptionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
+}
+
+// ValidateServiceBusSubscriptionId validates that the value is the ID of a ServiceBus Subscription
+func ValidateServiceBusSubscriptionId(i interface{}, k string) (warnings []string, errors []error) {
+	return serviceBusSubscriptionIdFormat.validate(i, k)
+}
+
+// ServiceBusSubscriptionRuleId is the ID of a ServiceBus Subscription Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/subscriptions/{subscriptionName}/rules/{name}`
+type ServiceBusSubscriptionRuleId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	NamespaceName    string
+	TopicName        string
+	SubscriptionName string
+	Name             string
+}
+
+var serviceBusSubscriptionRuleIdFormat = idFormat{
+	description: "ServiceBus Subscription Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ServiceBus"},
+		{key: "namespaces"},
+		{key: "topics"},
+		{key: "subscriptions"},
+		{key: "rules"},
+	},
+}
+
+// NewServiceBusSubscriptionRuleId returns the ID of the ServiceBus Subscription Rule
+func NewServiceBusSubscriptionRuleId(subscriptionId, resourceGroup, namespaceName, topicName, subscriptionName, name string) ServiceBusSubscriptionRuleId {
+	return ServiceBusSubscriptionRuleId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		NamespaceName:    namespaceName,
+		TopicName:        topicName,
+		SubscriptionName: subscriptionName,
+		Name:             name,
+	}
+}
+
+// ParseServiceBusSubscriptionRuleId parses the ID of a ServiceBus Subscription Rule
+func ParseServiceBusSubscriptionRuleId(input string) (*ServiceBusSubscriptionRuleId, error) {
+	values, err := serviceBusSubscriptionRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusSubscriptionRuleId{
+		SubscriptionId:   values[0],
+		ResourceGroup:    values[1],
+		NamespaceName:    values[2],
+		TopicName:        values[3],
+		SubscriptionName: values[4],
+		Name:             values[5],
+	}, nil
+}
+
+// String returns the ID of the ServiceBus Subscription Rule
+func (id ServiceBusSubscriptionRuleId) String() string {
+	return serviceBusSubscriptionRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.SubscriptionName, id.Name)
+}
+
+// ValidateServiceBusSubscriptionRuleId validates that the value is the ID of a ServiceBus Subscription Rule
+func ValidateServiceBusSubscriptionRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return serviceBusSubscriptionRuleIdFormat.validate(i, k)
+}
+
+// ServiceBusTopicAuthorizationRuleId is the ID of a ServiceBus Topic Authorization Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}/authorizationRules/{name}`
+type ServiceBusTopicAuthorizationRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	TopicName      string
+	Name           string
+}
+
+var serviceBusTopicAuthorizationRuleIdFormat = idFormat{
+	description: "ServiceBus Topic Authorization Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ServiceBus"},
+		{key: "namespaces"},
+		{key: "topics"},
+		{key: "authorizationRules"},
+	},
+}
+
+// NewServiceBusTopicAuthorizationRuleId returns the ID of the ServiceBus Topic Authorization Rule
+func NewServiceBusTopicAuthorizationRuleId(subscriptionId, resourceGroup, namespaceName, topicName, name string) ServiceBusTopicAuthorizationRuleId {
+	return ServiceBusTopicAuthorizationRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		TopicName:      topicName,
+		Name:           name,
+	}
+}
+
+// ParseServiceBusTopicAuthorizationRuleId parses the ID of a ServiceBus Topic Authorization Rule
+func ParseServiceBusTopicAuthorizationRuleId(input string) (*ServiceBusTopicAuthorizationRuleId, error) {
+	values, err := serviceBusTopicAuthorizationRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusTopicAuthorizationRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		TopicName:      values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// String returns the ID of the ServiceBus Topic Authorization Rule
+func (id ServiceBusTopicAuthorizationRuleId) String() string {
+	return serviceBusTopicAuthorizationRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
+}
+
+// ValidateServiceBusTopicAuthorizationRuleId validates that the value is the ID of a ServiceBus Topic Authorization Rule
+func ValidateServiceBusTopicAuthorizationRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return serviceBusTopicAuthorizationRuleIdFormat.validate(i, k)
+}
+
+// ServiceBusTopicId is the ID of a ServiceBus Topic in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{name}`
+type ServiceBusTopicId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+var serviceBusTopicIdFormat = idFormat{
+	description: "ServiceBus Topic",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ServiceBus"},
+		{key: "namespaces"},
+		{key: "topics"},
+	},
+}
+
+// NewServiceBusTopicId returns the ID of the ServiceBus Topic
+func NewServiceBusTopicId(subscriptionId, resourceGroup, namespaceName, name string) ServiceBusTopicId {
+	return ServiceBusTopicId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ParseServiceBusTopicId parses the ID of a ServiceBus Topic
+func ParseServiceBusTopicId(input string) (*ServiceBusTopicId, error) {
+	values, err := serviceBusTopicIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusTopicId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the ServiceBus Topic
+func (id ServiceBusTopicId) String() string {
+	return serviceBusTopicIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ValidateServiceBusTopicId validates that the value is the ID of a ServiceBus Topic
+func ValidateServiceBusTopicId(i interface{}, k string) (warnings []string, errors []error) {
+	return serviceBusTopicIdFormat.validate(i, k)
+}
+
+// ServiceFabricClusterId is the ID of a Service Fabric Cluster in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabric/clusters/{name}`
+type ServiceFabricClusterId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var serviceFabricClusterIdFormat = idFormat{
+	description: "Service Fabric Cluster",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ServiceFabric"},
+		{key: "clusters"},
+	},
+}
+
+// NewServiceFabricClusterId returns the ID of the Service Fabric Cluster
+func NewServiceFabricClusterId(subscriptionId, resourceGroup, name string) ServiceFabricClusterId {
+	return ServiceFabricClusterId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseServiceFabricClusterId parses the ID of a Service Fabric Cluster
+func ParseServiceFabricClusterId(input string) (*ServiceFabricClusterId, error) {
+	values, err := serviceFabricClusterIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceFabricClusterId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Service Fabric Cluster
+func (id ServiceFabricClusterId) String() string {
+	return serviceFabricClusterIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateServiceFabricClusterId validates that the value is the ID of a Service Fabric Cluster
+func ValidateServiceFabricClusterId(i interface{}, k string) (warnings []string, errors []error) {
+	return serviceFabricClusterIdFormat.validate(i, k)
+}
+
+// SharedImageGalleryId is the ID of a Shared Image Gallery in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{name}`
+type SharedImageGalleryId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var sharedImageGalleryIdFormat = idFormat{
+	description: "Shared Image Gallery",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "galleries"},
+	},
+}
+
+// NewSharedImageGalleryId returns the ID of the Shared Image Gallery
+func NewSharedImageGalleryId(subscriptionId, resourceGroup, name string) SharedImageGalleryId {
+	return SharedImageGalleryId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseSharedImageGalleryId parses the ID of a Shared Image Gallery
+func ParseSharedImageGalleryId(input string) (*SharedImageGalleryId, error) {
+	values, err := sharedImageGalleryIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedImageGalleryId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Shared Image Gallery
+func (id SharedImageGalleryId) String() string {
+	return sharedImageGalleryIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateSharedImageGalleryId validates that the value is the ID of a Shared Image Gallery
+func ValidateSharedImageGalleryId(i interface{}, k string) (warnings []string, errors []error) {
+	return sharedImageGalleryIdFormat.validate(i, k)
+}
+
+// SharedImageId is the ID of a Shared Image in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{name}`
+type SharedImageId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GalleryName    string
+	Name           string
+}
+
+var sharedImageIdFormat = idFormat{
+	description: "Shared Image",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "galleries"},
+		{key: "images"},
+	},
+}
+
+// NewSharedImageId returns the ID of the Shared Image
+func NewSharedImageId(subscriptionId, resourceGroup, galleryName, name string) SharedImageId {
+	return SharedImageId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		Name:           name,
+	}
+}
+
+// ParseSharedImageId parses the ID of a Shared Image
+func ParseSharedImageId(input string) (*SharedImageId, error) {
+	values, err := sharedImageIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedImageId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		GalleryName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Shared Image
+func (id SharedImageId) String() string {
+	return sharedImageIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.Name)
+}
+
+// ValidateSharedImageId validates that the value is the ID of a Shared Image
+func ValidateSharedImageId(i interface{}, k string) (warnings []string, errors []error) {
+	return sharedImageIdFormat.validate(i, k)
+}
+
+// SharedImageVersionId is the ID of a Shared Image Version in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{galleryName}/images/{imageName}/versions/{name}`
+type SharedImageVersionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GalleryName    string
+	ImageName      string
+	Name           string
+}
+
+var sharedImageVersionIdFormat = idFormat{
+	description: "Shared Image Version",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "galleries"},
+		{key: "images"},
+		{key: "versions"},
+	},
+}
+
+// NewSharedImageVersionId returns the ID of the Shared Image Version
+func NewSharedImageVersionId(subscriptionId, resourceGroup, galleryName, imageName, name string) SharedImageVersionId {
+	return SharedImageVersionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		ImageName:      imageName,
+		Name:           name,
+	}
+}
+
+// ParseSharedImageVersionId parses the ID of a Shared Image Version
+func ParseSharedImageVersionId(input string) (*SharedImageVersionId, error) {
+	values, err := sharedImageVersionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedImageVersionId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		GalleryName:    values[2],
+		ImageName:      values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// String returns the ID of the Shared Image Version
+func (id SharedImageVersionId) String() string {
+	return sharedImageVersionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName, id.Name)
+}
+
+// ValidateSharedImageVersionId validates that the value is the ID of a Shared Image Version
+func ValidateSharedImageVersionId(i interface{}, k string) (warnings []string, errors []error) {
+	return sharedImageVersionIdFormat.validate(i, k)
+}
+
+// SignalRServiceId is the ID of a SignalR Service in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.SignalRService/SignalR/{name}`
+type SignalRServiceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var signalRServiceIdFormat = idFormat{
+	description: "SignalR Service",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.SignalRService"},
+		{key: "SignalR"},
+	},
+}
+
+// NewSignalRServiceId returns the ID of the SignalR Service
+func NewSignalRServiceId(subscriptionId, resourceGroup, name string) SignalRServiceId {
+	return SignalRServiceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseSignalRServiceId parses the ID of a SignalR Service
+func ParseSignalRServiceId(input string) (*SignalRServiceId, error) {
+	values, err := signalRServiceIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignalRServiceId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the SignalR Service
+func (id SignalRServiceId) String() string {
+	return signalRServiceIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateSignalRServiceId validates that the value is the ID of a SignalR Service
+func ValidateSignalRServiceId(i interface{}, k string) (warnings []string, errors []error) {
+	return signalRServiceIdFormat.validate(i, k)
+}
+
+// SnapshotId is the ID of a Snapshot in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/snapshots/{name}`
+type SnapshotId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var snapshotIdFormat = idFormat{
+	description: "Snapshot",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "snapshots"},
+	},
+}
+
+// NewSnapshotId returns the ID of the Snapshot
+func NewSnapshotId(subscriptionId, resourceGroup, name string) SnapshotId {
+	return SnapshotId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseSnapshotId parses the ID of a Snapshot
+func ParseSnapshotId(input string) (*SnapshotId, error) {
+	values, err := snapshotIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Snapshot
+func (id SnapshotId) String() string {
+	return snapshotIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateSnapshotId validates that the value is the ID of a Snapshot
+func ValidateSnapshotId(i interface{}, k string) (warnings []string, errors []error) {
+	return snapshotIdFormat.validate(i, k)
+}
+
+// SqlDatabaseId is the ID of a SQL Database in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{name}`
+type SqlDatabaseId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var sqlDatabaseIdFormat = idFormat{
+	description: "SQL Database",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Sql"},
+		{key: "servers"},
+		{key: "databases"},
+	},
+}
+
+// NewSqlDatabaseId returns the ID of the SQL Database
+func NewSqlDatabaseId(subscriptionId, resourceGroup, serverName, name string) SqlDatabaseId {
+	return SqlDatabaseId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParseSqlDatabaseId parses the ID of a SQL Database
+func ParseSqlDatabaseId(input string) (*SqlDatabaseId, error) {
+	values, err := sqlDatabaseIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlDatabaseId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the SQL Database
+func (id SqlDatabaseId) String() string {
+	return sqlDatabaseIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidateSqlDatabaseId validates that the value is the ID of a SQL Database
+func ValidateSqlDatabaseId(i interface{}, k string) (warnings []string, errors []error) {
+	return sqlDatabaseIdFormat.validate(i, k)
+}
+
+// SqlElasticPoolId is the ID of a SQL Elastic Pool in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/elasticPools/{name}`
+type SqlElasticPoolId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var sqlElasticPoolIdFormat = idFormat{
+	description: "SQL Elastic Pool",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Sql"},
+		{key: "servers"},
+		{key: "elasticPools"},
+	},
+}
+
+// NewSqlElasticPoolId returns the ID of the SQL Elastic Pool
+func NewSqlElasticPoolId(subscriptionId, resourceGroup, serverName, name string) SqlElasticPoolId {
+	return SqlElasticPoolId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParseSqlElasticPoolId parses the ID of a SQL Elastic Pool
+func ParseSqlElasticPoolId(input string) (*SqlElasticPoolId, error) {
+	values, err := sqlElasticPoolIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlElasticPoolId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the SQL Elastic Pool
+func (id SqlElasticPoolId) String() string {
+	return sqlElasticPoolIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidateSqlElasticPoolId validates that the value is the ID of a SQL Elastic Pool
+func ValidateSqlElasticPoolId(i interface{}, k string) (warnings []string, errors []error) {
+	return sqlElasticPoolIdFormat.validate(i, k)
+}
+
+// SqlFirewallRuleId is the ID of a SQL Firewall Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/firewallRules/{name}`
+type SqlFirewallRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var sqlFirewallRuleIdFormat = idFormat{
+	description: "SQL Firewall Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Sql"},
+		{key: "servers"},
+		{key: "firewallRules"},
+	},
+}
+
+// NewSqlFirewallRuleId returns the ID of the SQL Firewall Rule
+func NewSqlFirewallRuleId(subscriptionId, resourceGroup, serverName, name string) SqlFirewallRuleId {
+	return SqlFirewallRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParseSqlFirewallRuleId parses the ID of a SQL Firewall Rule
+func ParseSqlFirewallRuleId(input string) (*SqlFirewallRuleId, error) {
+	values, err := sqlFirewallRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlFirewallRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the SQL Firewall Rule
+func (id SqlFirewallRuleId) String() string {
+	return sqlFirewallRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidateSqlFirewallRuleId validates that the value is the ID of a SQL Firewall Rule
+func ValidateSqlFirewallRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return sqlFirewallRuleIdFormat.validate(i, k)
+}
+
+// SqlServerId is the ID of a SQL Server in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}`
+type SqlServerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var sqlServerIdFormat = idFormat{
+	description: "SQL Server",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Sql"},
+		{key: "servers"},
+	},
+}
+
+// NewSqlServerId returns the ID of the SQL Server
+func NewSqlServerId(subscriptionId, resourceGroup, name string) SqlServerId {
+	return SqlServerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseSqlServerId parses the ID of a SQL Server
+func ParseSqlServerId(input string) (*SqlServerId, error) {
+	values, err := sqlServerIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlServerId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the SQL Server
+func (id SqlServerId) String() string {
+	return sqlServerIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateSqlServerId validates that the value is the ID of a SQL Server
+func ValidateSqlServerId(i interface{}, k string) (warnings []string, errors []error) {
+	return sqlServerIdFormat.validate(i, k)
+}
+
+// SqlVirtualNetworkRuleId is the ID of a SQL Virtual Network Rule in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/virtualNetworkRules/{name}`
+type SqlVirtualNetworkRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+var sqlVirtualNetworkRuleIdFormat = idFormat{
+	description: "SQL Virtual Network Rule",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Sql"},
+		{key: "servers"},
+		{key: "virtualNetworkRules"},
+	},
+}
+
+// NewSqlVirtualNetworkRuleId returns the ID of the SQL Virtual Network Rule
+func NewSqlVirtualNetworkRuleId(subscriptionId, resourceGroup, serverName, name string) SqlVirtualNetworkRuleId {
+	return SqlVirtualNetworkRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ParseSqlVirtualNetworkRuleId parses the ID of a SQL Virtual Network Rule
+func ParseSqlVirtualNetworkRuleId(input string) (*SqlVirtualNetworkRuleId, error) {
+	values, err := sqlVirtualNetworkRuleIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlVirtualNetworkRuleId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the SQL Virtual Network Rule
+func (id SqlVirtualNetworkRuleId) String() string {
+	return sqlVirtualNetworkRuleIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ValidateSqlVirtualNetworkRuleId validates that the value is the ID of a SQL Virtual Network Rule
+func ValidateSqlVirtualNetworkRuleId(i interface{}, k string) (warnings []string, errors []error) {
+	return sqlVirtualNetworkRuleIdFormat.validate(i, k)
+}
+
+// StorageAccountId is the ID of a Storage Account in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}`
+type StorageAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var storageAccountIdFormat = idFormat{
+	description: "Storage Account",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Storage"},
+		{key: "storageAccounts"},
+	},
+}
+
+// NewStorageAccountId returns the ID of the Storage Account
+func NewStorageAccountId(subscriptionId, resourceGroup, name string) StorageAccountId {
+	return StorageAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseStorageAccountId parses the ID of a Storage Account
+func ParseStorageAccountId(input string) (*StorageAccountId, error) {
+	values, err := storageAccountIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageAccountId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Storage Account
+func (id StorageAccountId) String() string {
+	return storageAccountIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateStorageAccountId validates that the value is the ID of a Storage Account
+func ValidateStorageAccountId(i interface{}, k string) (warnings []string, errors []error) {
+	return storageAccountIdFormat.validate(i, k)
+}
+
+// SubnetId is the ID of a Subnet in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{name}`
+type SubnetId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+var subnetIdFormat = idFormat{
+	description: "Subnet",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "virtualNetworks"},
+		{key: "subnets"},
+	},
+}
+
+// NewSubnetId returns the ID of the Subnet
+func NewSubnetId(subscriptionId, resourceGroup, virtualNetworkName, name string) SubnetId {
+	return SubnetId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualNetworkName: virtualNetworkName,
+		Name:               name,
+	}
+}
+
+// ParseSubnetId parses the ID of a Subnet
+func ParseSubnetId(input string) (*SubnetId, error) {
+	values, err := subnetIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubnetId{
+		SubscriptionId:     values[0],
+		ResourceGroup:      values[1],
+		VirtualNetworkName: values[2],
+		Name:               values[3],
+	}, nil
+}
+
+// String returns the ID of the Subnet
+func (id SubnetId) String() string {
+	return subnetIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+// ValidateSubnetId validates that the value is the ID of a Subnet
+func ValidateSubnetId(i interface{}, k string) (warnings []string, errors []error) {
+	return subnetIdFormat.validate(i, k)
+}
+
+// TemplateDeploymentId is the ID of a Template Deployment in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Resources/deployments/{name}`
+type TemplateDeploymentId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var templateDeploymentIdFormat = idFormat{
+	description: "Template Deployment",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Resources"},
+		{key: "deployments"},
+	},
+}
+
+// NewTemplateDeploymentId returns the ID of the Template Deployment
+func NewTemplateDeploymentId(subscriptionId, resourceGroup, name string) TemplateDeploymentId {
+	return TemplateDeploymentId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseTemplateDeploymentId parses the ID of a Template Deployment
+func ParseTemplateDeploymentId(input string) (*TemplateDeploymentId, error) {
+	values, err := templateDeploymentIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateDeploymentId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Template Deployment
+func (id TemplateDeploymentId) String() string {
+	return templateDeploymentIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateTemplateDeploymentId validates that the value is the ID of a Template Deployment
+func ValidateTemplateDeploymentId(i interface{}, k string) (warnings []string, errors []error) {
+	return templateDeploymentIdFormat.validate(i, k)
+}
+
+// TrafficManagerProfileId is the ID of a Traffic Manager Profile in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{name}`
+type TrafficManagerProfileId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var trafficManagerProfileIdFormat = idFormat{
+	description: "Traffic Manager Profile",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "trafficManagerProfiles"},
+	},
+}
+
+// NewTrafficManagerProfileId returns the ID of the Traffic Manager Profile
+func NewTrafficManagerProfileId(subscriptionId, resourceGroup, name string) TrafficManagerProfileId {
+	return TrafficManagerProfileId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseTrafficManagerProfileId parses the ID of a Traffic Manager Profile
+func ParseTrafficManagerProfileId(input string) (*TrafficManagerProfileId, error) {
+	values, err := trafficManagerProfileIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrafficManagerProfileId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Traffic Manager Profile
+func (id TrafficManagerProfileId) String() string {
+	return trafficManagerProfileIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateTrafficManagerProfileId validates that the value is the ID of a Traffic Manager Profile
+func ValidateTrafficManagerProfileId(i interface{}, k string) (warnings []string, errors []error) {
+	return trafficManagerProfileIdFormat.validate(i, k)
+}
+
+// UserAssignedIdentityId is the ID of an User Assigned Identity in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}`
+type UserAssignedIdentityId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var userAssignedIdentityIdFormat = idFormat{
+	description: "User Assigned Identity",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.ManagedIdentity"},
+		{key: "userAssignedIdentities"},
+	},
+}
+
+// NewUserAssignedIdentityId returns the ID of the User Assigned Identity
+func NewUserAssignedIdentityId(subscriptionId, resourceGroup, name string) UserAssignedIdentityId {
+	return UserAssignedIdentityId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseUserAssignedIdentityId parses the ID of an User Assigned Identity
+func ParseUserAssignedIdentityId(input string) (*UserAssignedIdentityId, error) {
+	values, err := userAssignedIdentityIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserAssignedIdentityId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the User Assigned Identity
+func (id UserAssignedIdentityId) String() string {
+	return userAssignedIdentityIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateUserAssignedIdentityId validates that the value is the ID of an User Assigned Identity
+func ValidateUserAssignedIdentityId(i interface{}, k string) (warnings []string, errors []error) {
+	return userAssignedIdentityIdFormat.validate(i, k)
+}
+
+// VirtualHubConnectionId is the ID of a Virtual Hub Connection in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHubName}/hubVirtualNetworkConnections/{name}`
+type VirtualHubConnectionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VirtualHubName string
+	Name           string
+}
+
+var virtualHubConnectionIdFormat = idFormat{
+	description: "Virtual Hub Connection",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "virtualHubs"},
+		{key: "hubVirtualNetworkConnections"},
+	},
+}
+
+// NewVirtualHubConnectionId returns the ID of the Virtual Hub Connection
+func NewVirtualHubConnectionId(subscriptionId, resourceGroup, virtualHubName, name string) VirtualHubConnectionId {
+	return VirtualHubConnectionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VirtualHubName: virtualHubName,
+		Name:           name,
+	}
+}
+
+// ParseVirtualHubConnectionId parses the ID of a Virtual Hub Connection
+func ParseVirtualHubConnectionId(input string) (*VirtualHubConnectionId, error) {
+	values, err := virtualHubConnectionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualHubConnectionId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		VirtualHubName: values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the Virtual Hub Connection
+func (id VirtualHubConnectionId) String() string {
+	return virtualHubConnectionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
+}
+
+// ValidateVirtualHubConnectionId validates that the value is the ID of a Virtual Hub Connection
+func ValidateVirtualHubConnectionId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualHubConnectionIdFormat.validate(i, k)
+}
+
+// VirtualHubId is the ID of a Virtual Hub in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{name}`
+type VirtualHubId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var virtualHubIdFormat = idFormat{
+	description: "Virtual Hub",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "virtualHubs"},
+	},
+}
+
+// NewVirtualHubId returns the ID of the Virtual Hub
+func NewVirtualHubId(subscriptionId, resourceGroup, name string) VirtualHubId {
+	return VirtualHubId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseVirtualHubId parses the ID of a Virtual Hub
+func ParseVirtualHubId(input string) (*VirtualHubId, error) {
+	values, err := virtualHubIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualHubId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Virtual Hub
+func (id VirtualHubId) String() string {
+	return virtualHubIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualHubId validates that the value is the ID of a Virtual Hub
+func ValidateVirtualHubId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualHubIdFormat.validate(i, k)
+}
+
+// VirtualMachineExtensionId is the ID of a Virtual Machine Extension in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachineName}/extensions/{name}`
+type VirtualMachineExtensionId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+var virtualMachineExtensionIdFormat = idFormat{
+	description: "Virtual Machine Extension",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "virtualMachines"},
+		{key: "extensions"},
+	},
+}
+
+// NewVirtualMachineExtensionId returns the ID of the Virtual Machine Extension
+func NewVirtualMachineExtensionId(subscriptionId, resourceGroup, virtualMachineName, name string) VirtualMachineExtensionId {
+	return VirtualMachineExtensionId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+		Name:               name,
+	}
+}
+
+// ParseVirtualMachineExtensionId parses the ID of a Virtual Machine Extension
+func ParseVirtualMachineExtensionId(input string) (*VirtualMachineExtensionId, error) {
+	values, err := virtualMachineExtensionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineExtensionId{
+		SubscriptionId:     values[0],
+		ResourceGroup:      values[1],
+		VirtualMachineName: values[2],
+		Name:               values[3],
+	}, nil
+}
+
+// String returns the ID of the Virtual Machine Extension
+func (id VirtualMachineExtensionId) String() string {
+	return virtualMachineExtensionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+// ValidateVirtualMachineExtensionId validates that the value is the ID of a Virtual Machine Extension
+func ValidateVirtualMachineExtensionId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualMachineExtensionIdFormat.validate(i, k)
+}
+
+// VirtualMachineId is the ID of a Virtual Machine in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{name}`
+type VirtualMachineId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var virtualMachineIdFormat = idFormat{
+	description: "Virtual Machine",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "virtualMachines"},
+	},
+}
+
+// NewVirtualMachineId returns the ID of the Virtual Machine
+func NewVirtualMachineId(subscriptionId, resourceGroup, name string) VirtualMachineId {
+	return VirtualMachineId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseVirtualMachineId parses the ID of a Virtual Machine
+func ParseVirtualMachineId(input string) (*VirtualMachineId, error) {
+	values, err := virtualMachineIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Virtual Machine
+func (id VirtualMachineId) String() string {
+	return virtualMachineIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualMachineId validates that the value is the ID of a Virtual Machine
+func ValidateVirtualMachineId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualMachineIdFormat.validate(i, k)
+}
+
+// VirtualMachineRunCommandId is the ID of a Virtual Machine Run Command in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachineName}/runCommands/{name}`
+type VirtualMachineRunCommandId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualMachineName string
+	Name               string
+}
+
+var virtualMachineRunCommandIdFormat = idFormat{
+	description: "Virtual Machine Run Command",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "virtualMachines"},
+		{key: "runCommands"},
+	},
+}
+
+// NewVirtualMachineRunCommandId returns the ID of the Virtual Machine Run Command
+func NewVirtualMachineRunCommandId(subscriptionId, resourceGroup, virtualMachineName, name string) VirtualMachineRunCommandId {
+	return VirtualMachineRunCommandId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualMachineName: virtualMachineName,
+		Name:               name,
+	}
+}
+
+// ParseVirtualMachineRunCommandId parses the ID of a Virtual Machine Run Command
+func ParseVirtualMachineRunCommandId(input string) (*VirtualMachineRunCommandId, error) {
+	values, err := virtualMachineRunCommandIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineRunCommandId{
+		SubscriptionId:     values[0],
+		ResourceGroup:      values[1],
+		VirtualMachineName: values[2],
+		Name:               values[3],
+	}, nil
+}
+
+// String returns the ID of the Virtual Machine Run Command
+func (id VirtualMachineRunCommandId) String() string {
+	return virtualMachineRunCommandIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.Name)
+}
+
+// ValidateVirtualMachineRunCommandId validates that the value is the ID of a Virtual Machine Run Command
+func ValidateVirtualMachineRunCommandId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualMachineRunCommandIdFormat.validate(i, k)
+}
+
+// VirtualMachineScaleSetExtensionId is the ID of a Virtual Machine Scale Set Extension in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{virtualMachineScaleSetName}/extensions/{name}`
+type VirtualMachineScaleSetExtensionId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	VirtualMachineScaleSetName string
+	Name                       string
+}
+
+var virtualMachineScaleSetExtensionIdFormat = idFormat{
+	description: "Virtual Machine Scale Set Extension",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "virtualMachineScaleSets"},
+		{key: "extensions"},
+	},
+}
+
+// NewVirtualMachineScaleSetExtensionId returns the ID of the Virtual Machine Scale Set Extension
+func NewVirtualMachineScaleSetExtensionId(subscriptionId, resourceGroup, virtualMachineScaleSetName, name string) VirtualMachineScaleSetExtensionId {
+	return VirtualMachineScaleSetExtensionId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		VirtualMachineScaleSetName: virtualMachineScaleSetName,
+		Name:                       name,
+	}
+}
+
+// ParseVirtualMachineScaleSetExtensionId parses the ID of a Virtual Machine Scale Set Extension
+func ParseVirtualMachineScaleSetExtensionId(input string) (*VirtualMachineScaleSetExtensionId, error) {
+	values, err := virtualMachineScaleSetExtensionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineScaleSetExtensionId{
+		SubscriptionId:             values[0],
+		ResourceGroup:              values[1],
+		VirtualMachineScaleSetName: values[2],
+		Name:                       values[3],
+	}, nil
+}
+
+// String returns the ID of the Virtual Machine Scale Set Extension
+func (id VirtualMachineScaleSetExtensionId) String() string {
+	return virtualMachineScaleSetExtensionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name)
+}
+
+// ValidateVirtualMachineScaleSetExtensionId validates that the value is the ID of a Virtual Machine Scale Set Extension
+func ValidateVirtualMachineScaleSetExtensionId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualMachineScaleSetExtensionIdFormat.validate(i, k)
+}
+
+// VirtualMachineScaleSetId is the ID of a Virtual Machine Scale Set in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{name}`
+type VirtualMachineScaleSetId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var virtualMachineScaleSetIdFormat = idFormat{
+	description: "Virtual Machine Scale Set",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Compute"},
+		{key: "virtualMachineScaleSets"},
+	},
+}
+
+// NewVirtualMachineScaleSetId returns the ID of the Virtual Machine Scale Set
+func NewVirtualMachineScaleSetId(subscriptionId, resourceGroup, name string) VirtualMachineScaleSetId {
+	return VirtualMachineScaleSetId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseVirtualMachineScaleSetId parses the ID of a Virtual Machine Scale Set
+func ParseVirtualMachineScaleSetId(input string) (*VirtualMachineScaleSetId, error) {
+	values, err := virtualMachineScaleSetIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineScaleSetId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Virtual Machine Scale Set
+func (id VirtualMachineScaleSetId) String() string {
+	return virtualMachineScaleSetIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualMachineScaleSetId validates that the value is the ID of a Virtual Machine Scale Set
+func ValidateVirtualMachineScaleSetId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualMachineScaleSetIdFormat.validate(i, k)
+}
+
+// VirtualNetworkGatewayConnectionId is the ID of a Virtual Network Gateway Connection in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/connections/{name}`
+type VirtualNetworkGatewayConnectionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var virtualNetworkGatewayConnectionIdFormat = idFormat{
+	description: "Virtual Network Gateway Connection",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "connections"},
+	},
+}
+
+// NewVirtualNetworkGatewayConnectionId returns the ID of the Virtual Network Gateway Connection
+func NewVirtualNetworkGatewayConnectionId(subscriptionId, resourceGroup, name string) VirtualNetworkGatewayConnectionId {
+	return VirtualNetworkGatewayConnectionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseVirtualNetworkGatewayConnectionId parses the ID of a Virtual Network Gateway Connection
+func ParseVirtualNetworkGatewayConnectionId(input string) (*VirtualNetworkGatewayConnectionId, error) {
+	values, err := virtualNetworkGatewayConnectionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkGatewayConnectionId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Virtual Network Gateway Connection
+func (id VirtualNetworkGatewayConnectionId) String() string {
+	return virtualNetworkGatewayConnectionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualNetworkGatewayConnectionId validates that the value is the ID of a Virtual Network Gateway Connection
+func ValidateVirtualNetworkGatewayConnectionId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualNetworkGatewayConnectionIdFormat.validate(i, k)
+}
+
+// VirtualNetworkGatewayId is the ID of a Virtual Network Gateway in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{name}`
+type VirtualNetworkGatewayId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var virtualNetworkGatewayIdFormat = idFormat{
+	description: "Virtual Network Gateway",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "virtualNetworkGateways"},
+	},
+}
+
+// NewVirtualNetworkGatewayId returns the ID of the Virtual Network Gateway
+func NewVirtualNetworkGatewayId(subscriptionId, resourceGroup, name string) VirtualNetworkGatewayId {
+	return VirtualNetworkGatewayId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseVirtualNetworkGatewayId parses the ID of a Virtual Network Gateway
+func ParseVirtualNetworkGatewayId(input string) (*VirtualNetworkGatewayId, error) {
+	values, err := virtualNetworkGatewayIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkGatewayId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Virtual Network Gateway
+func (id VirtualNetworkGatewayId) String() string {
+	return virtualNetworkGatewayIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualNetworkGatewayId validates that the value is the ID of a Virtual Network Gateway
+func ValidateVirtualNetworkGatewayId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualNetworkGatewayIdFormat.validate(i, k)
+}
+
+// VirtualNetworkId is the ID of a Virtual Network in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}`
+type VirtualNetworkId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var virtualNetworkIdFormat = idFormat{
+	description: "Virtual Network",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "virtualNetworks"},
+	},
+}
+
+// NewVirtualNetworkId returns the ID of the Virtual Network
+func NewVirtualNetworkId(subscriptionId, resourceGroup, name string) VirtualNetworkId {
+	return VirtualNetworkId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseVirtualNetworkId parses the ID of a Virtual Network
+func ParseVirtualNetworkId(input string) (*VirtualNetworkId, error) {
+	values, err := virtualNetworkIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Virtual Network
+func (id VirtualNetworkId) String() string {
+	return virtualNetworkIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualNetworkId validates that the value is the ID of a Virtual Network
+func ValidateVirtualNetworkId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualNetworkIdFormat.validate(i, k)
+}
+
+// VirtualNetworkPeeringId is the ID of a Virtual Network Peering in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/virtualNetworkPeerings/{name}`
+type VirtualNetworkPeeringId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+var virtualNetworkPeeringIdFormat = idFormat{
+	description: "Virtual Network Peering",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "virtualNetworks"},
+		{key: "virtualNetworkPeerings"},
+	},
+}
+
+// NewVirtualNetworkPeeringId returns the ID of the Virtual Network Peering
+func NewVirtualNetworkPeeringId(subscriptionId, resourceGroup, virtualNetworkName, name string) VirtualNetworkPeeringId {
+	return VirtualNetworkPeeringId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		VirtualNetworkName: virtualNetworkName,
+		Name:               name,
+	}
+}
+
+// ParseVirtualNetworkPeeringId parses the ID of a Virtual Network Peering
+func ParseVirtualNetworkPeeringId(input string) (*VirtualNetworkPeeringId, error) {
+	values, err := virtualNetworkPeeringIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkPeeringId{
+		SubscriptionId:     values[0],
+		ResourceGroup:      values[1],
+		VirtualNetworkName: values[2],
+		Name:               values[3],
+	}, nil
+}
+
+// String returns the ID of the Virtual Network Peering
+func (id VirtualNetworkPeeringId) String() string {
+	return virtualNetworkPeeringIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+// ValidateVirtualNetworkPeeringId validates that the value is the ID of a Virtual Network Peering
+func ValidateVirtualNetworkPeeringId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualNetworkPeeringIdFormat.validate(i, k)
+}
+
+// VirtualWanId is the ID of a Virtual WAN in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualWans/{name}`
+type VirtualWanId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var virtualWanIdFormat = idFormat{
+	description: "Virtual WAN",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "virtualWans"},
+	},
+}
+
+// NewVirtualWanId returns the ID of the Virtual WAN
+func NewVirtualWanId(subscriptionId, resourceGroup, name string) VirtualWanId {
+	return VirtualWanId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseVirtualWanId parses the ID of a Virtual WAN
+func ParseVirtualWanId(input string) (*VirtualWanId, error) {
+	values, err := virtualWanIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualWanId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the Virtual WAN
+func (id VirtualWanId) String() string {
+	return virtualWanIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualWanId validates that the value is the ID of a Virtual WAN
+func ValidateVirtualWanId(i interface{}, k string) (warnings []string, errors []error) {
+	return virtualWanIdFormat.validate(i, k)
+}
+
+// VpnGatewayConnectionId is the ID of a VPN Gateway Connection in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{vpnGatewayName}/vpnConnections/{name}`
+type VpnGatewayConnectionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VpnGatewayName string
+	Name           string
+}
+
+var vpnGatewayConnectionIdFormat = idFormat{
+	description: "VPN Gateway Connection",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "vpnGateways"},
+		{key: "vpnConnections"},
+	},
+}
+
+// NewVpnGatewayConnectionId returns the ID of the VPN Gateway Connection
+func NewVpnGatewayConnectionId(subscriptionId, resourceGroup, vpnGatewayName, name string) VpnGatewayConnectionId {
+	return VpnGatewayConnectionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VpnGatewayName: vpnGatewayName,
+		Name:           name,
+	}
+}
+
+// ParseVpnGatewayConnectionId parses the ID of a VPN Gateway Connection
+func ParseVpnGatewayConnectionId(input string) (*VpnGatewayConnectionId, error) {
+	values, err := vpnGatewayConnectionIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VpnGatewayConnectionId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		VpnGatewayName: values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// String returns the ID of the VPN Gateway Connection
+func (id VpnGatewayConnectionId) String() string {
+	return vpnGatewayConnectionIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName, id.Name)
+}
+
+// ValidateVpnGatewayConnectionId validates that the value is the ID of a VPN Gateway Connection
+func ValidateVpnGatewayConnectionId(i interface{}, k string) (warnings []string, errors []error) {
+	return vpnGatewayConnectionIdFormat.validate(i, k)
+}
+
+// VpnGatewayId is the ID of a VPN Gateway in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{name}`
+type VpnGatewayId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var vpnGatewayIdFormat = idFormat{
+	description: "VPN Gateway",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "vpnGateways"},
+	},
+}
+
+// NewVpnGatewayId returns the ID of the VPN Gateway
+func NewVpnGatewayId(subscriptionId, resourceGroup, name string) VpnGatewayId {
+	return VpnGatewayId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseVpnGatewayId parses the ID of a VPN Gateway
+func ParseVpnGatewayId(input string) (*VpnGatewayId, error) {
+	values, err := vpnGatewayIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VpnGatewayId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the VPN Gateway
+func (id VpnGatewayId) String() string {
+	return vpnGatewayIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateVpnGatewayId validates that the value is the ID of a VPN Gateway
+func ValidateVpnGatewayId(i interface{}, k string) (warnings []string, errors []error) {
+	return vpnGatewayIdFormat.validate(i, k)
+}
+
+// VpnSiteId is the ID of a VPN Site in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnSites/{name}`
+type VpnSiteId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+var vpnSiteIdFormat = idFormat{
+	description: "VPN Site",
+	segments: []segment{
+		{key: "subscriptions"},
+		{key: "resourceGroups"},
+		{key: "providers", fixedValue: "Microsoft.Network"},
+		{key: "vpnSites"},
+	},
+}
+
+// NewVpnSiteId returns the ID of the VPN Site
+func NewVpnSiteId(subscriptionId, resourceGroup, name string) VpnSiteId {
+	return VpnSiteId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ParseVpnSiteId parses the ID of a VPN Site
+func ParseVpnSiteId(input string) (*VpnSiteId, error) {
+	values, err := vpnSiteIdFormat.parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VpnSiteId{
+		SubscriptionId: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// String returns the ID of the VPN Site
+func (id VpnSiteId) String() string {
+	return vpnSiteIdFormat.format(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ValidateVpnSiteId validates that the value is the ID of a VPN Site
+func ValidateVpnSiteId(i interface{}, k string) (warnings []string, errors []error) {
+	return vpnSiteIdFormat.validate(i, k)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func AccRandTimeInt() int {
@@ -23,5 +24,6 @@ func AccRandTimeInt() int {
 		panic(err)
 	}
 
-	return i
+	// when recording/replaying requests this is swapped for a stable value, so that the requests match
+	return azure.RecordRandomInt(i)
 }
@@ -5,14 +5,20 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"log"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 // Provider returns a terraform.ResourceProvider.
@@ -75,6 +81,36 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
 			},
 
+			// OIDC (Federated Token) specific fields
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+			},
+			"oidc_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+				ConflictsWith: []string{"oidc_token_file_path"},
+			},
+			"oidc_token_file_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("ARM_OIDC_TOKEN_FILE_PATH", ""),
+				ConflictsWith: []string{"oidc_token"},
+			},
+
+			"auxiliary_tenant_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: azure.MaxAuxiliaryTenants,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+
 			// Managed Tracking GUID for User-agent
 			"partner_id": {
 				Type:         schema.TypeString,
@@ -95,6 +131,87 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"validate_resource_skus": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_VALIDATE_RESOURCE_SKUS", false),
+			},
+
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateAzureRMTags,
+						},
+					},
+				},
+			},
+
+			"ignore_tag_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				Set: schema.HashString,
+			},
+
+			"ignore_tag_prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				Set: schema.HashString,
+			},
+
+			"retry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"min_backoff_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"max_backoff_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"status_codes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(400, 599),
+							},
+							Set: schema.HashInt,
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -112,6 +229,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_azuread_service_principal":              dataSourceArmActiveDirectoryServicePrincipal(),
 			"azurerm_batch_account":                          dataSourceArmBatchAccount(),
 			"azurerm_batch_pool":                             dataSourceArmBatchPool(),
+			"azurerm_bgp_service_communities":                dataSourceArmBgpServiceCommunities(),
 			"azurerm_builtin_role_definition":                dataSourceArmBuiltInRoleDefinition(),
 			"azurerm_cdn_profile":                            dataSourceArmCdnProfile(),
 			"azurerm_client_config":                          dataSourceArmClientConfig(),
@@ -143,17 +261,20 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_notification_hub":                       dataSourceNotificationHub(),
 			"azurerm_platform_image":                         dataSourceArmPlatformImage(),
 			"azurerm_policy_definition":                      dataSourceArmPolicyDefinition(),
+			"azurerm_private_endpoint":                       dataSourceArmPrivateEndpoint(),
 			"azurerm_public_ip":                              dataSourceArmPublicIP(),
 			"azurerm_public_ips":                             dataSourceArmPublicIPs(),
 			"azurerm_recovery_services_vault":                dataSourceArmRecoveryServicesVault(),
 			"azurerm_recovery_services_protection_policy_vm": dataSourceArmRecoveryServicesProtectionPolicyVm(),
 			"azurerm_resource_group":                         dataSourceArmResourceGroup(),
+			"azurerm_resource_skus":                          dataSourceArmResourceSkus(),
 			"azurerm_role_definition":                        dataSourceArmRoleDefinition(),
 			"azurerm_route_table":                            dataSourceArmRouteTable(),
 			"azurerm_scheduler_job_collection":               dataSourceArmSchedulerJobCollection(),
 			"azurerm_servicebus_namespace":                   dataSourceArmServiceBusNamespace(),
 			"azurerm_shared_image_gallery":                   dataSourceArmSharedImageGallery(),
 			"azurerm_shared_image_version":                   dataSourceArmSharedImageVersion(),
+			"azurerm_shared_image_versions":                  dataSourceArmSharedImageVersions(),
 			"azurerm_shared_image":                           dataSourceArmSharedImage(),
 			"azurerm_snapshot":                               dataSourceArmSnapshot(),
 			"azurerm_storage_account_sas":                    dataSourceArmStorageAccountSharedAccessSignature(),
@@ -162,9 +283,14 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_subscription":                           dataSourceArmSubscription(),
 			"azurerm_subscriptions":                          dataSourceArmSubscriptions(),
 			"azurerm_traffic_manager_geographical_location":  dataSourceArmTrafficManagerGeographicalLocation(),
+			"azurerm_virtual_hub":                            dataSourceArmVirtualHub(),
 			"azurerm_virtual_machine":                        dataSourceArmVirtualMachine(),
+			"azurerm_virtual_machine_scale_set_instances":    dataSourceArmVirtualMachineScaleSetInstances(),
 			"azurerm_virtual_network_gateway":                dataSourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network":                        dataSourceArmVirtualNetwork(),
+			"azurerm_virtual_wan":                            dataSourceArmVirtualWan(),
+			"azurerm_vpn_gateway":                            dataSourceArmVpnGateway(),
+			"azurerm_vpn_site":                               dataSourceArmVpnSite(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -269,6 +395,8 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_lb_outbound_rule":                       resourceArmLoadBalancerOutboundRule(),
 			"azurerm_lb_rule":                                resourceArmLoadBalancerRule(),
 			"azurerm_lb":                                     resourceArmLoadBalancer(),
+			"azurerm_linux_virtual_machine":                  resourceArmLinuxVirtualMachine(),
+			"azurerm_linux_virtual_machine_scale_set":        resourceArmLinuxVirtualMachineScaleSet(),
 			"azurerm_local_network_gateway":                  resourceArmLocalNetworkGateway(),
 			"azurerm_log_analytics_solution":                 resourceArmLogAnalyticsSolution(),
 			"azurerm_log_analytics_linked_service":           resourceArmLogAnalyticsLinkedService(),
@@ -308,6 +436,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
 			"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
 			"azurerm_network_watcher":                                                        resourceArmNetworkWatcher(),
+			"azurerm_network_watcher_flow_log":                                               resourceArmNetworkWatcherFlowLog(),
 			"azurerm_notification_hub_authorization_rule":                                    resourceArmNotificationHubAuthorizationRule(),
 			"azurerm_notification_hub_namespace":                                             resourceArmNotificationHubNamespace(),
 			"azurerm_notification_hub":                                                       resourceArmNotificationHub(),
@@ -320,7 +449,9 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_postgresql_firewall_rule":                                               resourceArmPostgreSQLFirewallRule(),
 			"azurerm_postgresql_server":                                                      resourceArmPostgreSQLServer(),
 			"azurerm_postgresql_virtual_network_rule":                                        resourceArmPostgreSQLVirtualNetworkRule(),
+			"azurerm_private_endpoint":                                                       resourceArmPrivateEndpoint(),
 			"azurerm_public_ip":                                                              resourceArmPublicIp(),
+			"azurerm_public_ip_prefix":                                                       resourceArmPublicIpPrefix(),
 			"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
 			"azurerm_recovery_services_protection_policy_vm":                                 resourceArmRecoveryServicesProtectionPolicyVm(),
 			"azurerm_recovery_services_vault":                                                resourceArmRecoveryServicesVault(),
@@ -330,6 +461,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_resource_group":                                                         resourceArmResourceGroup(),
 			"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 			"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
+			"azurerm_route_filter":                                                           resourceArmRouteFilter(),
 			"azurerm_route_table":                                                            resourceArmRouteTable(),
 			"azurerm_route":                                                                  resourceArmRoute(),
 			"azurerm_scheduler_job_collection":                                               resourceArmSchedulerJobCollection(),
@@ -371,22 +503,69 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
 			"azurerm_user_assigned_identity":                                                 resourceArmUserAssignedIdentity(),
+			"azurerm_virtual_hub":                                                            resourceArmVirtualHub(),
+			"azurerm_virtual_hub_connection":                                                 resourceArmVirtualHubConnection(),
 			"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
 			"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
+			"azurerm_virtual_machine_run_command":                                            resourceArmVirtualMachineRunCommand(),
 			"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_machine_scale_set_extension":                                    resourceArmVirtualMachineScaleSetExtension(),
 			"azurerm_virtual_machine":                                                        resourceArmVirtualMachine(),
 			"azurerm_virtual_network_gateway_connection":                                     resourceArmVirtualNetworkGatewayConnection(),
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_virtual_wan":                                                            resourceArmVirtualWan(),
+			"azurerm_vpn_gateway":                                                            resourceArmVpnGateway(),
+			"azurerm_vpn_gateway_connection":                                                 resourceArmVpnGatewayConnection(),
+			"azurerm_vpn_site":                                                               resourceArmVpnSite(),
+			"azurerm_windows_virtual_machine":                                                resourceArmWindowsVirtualMachine(),
+			"azurerm_windows_virtual_machine_scale_set":                                      resourceArmWindowsVirtualMachineScaleSet(),
 		},
 	}
 
+	// include the ID's of the requests sent to Azure in any errors returned
+	for _, r := range p.DataSourcesMap {
+		trackRequestsForResource(r)
+	}
+	for _, r := range p.ResourcesMap {
+		preserveIgnoredTagsForResource(r)
+		trackRequestsForResource(r)
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
 }
 
+// trackRequestsForResource wraps each of the CRUD functions of the Resource so that the ID's of the
+// requests made during the operation are appended to any error returned
+func trackRequestsForResource(r *schema.Resource) {
+	wrap := func(f func(d *schema.ResourceData, meta interface{}) error) func(d *schema.ResourceData, meta interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			client, tracker := meta.(*ArmClient).withRequestTracker()
+			return tracker.Annotate(f(d, client))
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			client, tracker := meta.(*ArmClient).withRequestTracker()
+			result, err := exists(d, client)
+			return result, tracker.Annotate(err)
+		}
+	}
+}
+
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
 		builder := &authentication.Builder{
@@ -406,20 +585,65 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			SupportsAzureCliToken:          true,
 		}
 
-		config, err := builder.Build()
-		if err != nil {
-			return nil, fmt.Errorf("Error building AzureRM Client: %s", err)
+		authOptions := armClientAuthOptions{
+			auxiliaryTenantIds: expandProviderAuxiliaryTenantIds(d.Get("auxiliary_tenant_ids").([]interface{})),
+		}
+		if len(authOptions.auxiliaryTenantIds) > azure.MaxAuxiliaryTenants {
+			return nil, fmt.Errorf("Error building AzureRM Client: a maximum of %d Auxiliary Tenants can be specified", azure.MaxAuxiliaryTenants)
+		}
+
+		var config *authentication.Config
+		if d.Get("use_oidc").(bool) {
+			// OIDC isn't supported by the `authentication` package, so the Config is built here
+			clientAssertion := azure.ClientAssertionAuth{
+				ClientId:      builder.ClientID,
+				TenantId:      builder.TenantID,
+				Token:         d.Get("oidc_token").(string),
+				TokenFilePath: d.Get("oidc_token_file_path").(string),
+			}
+			if err := clientAssertion.Validate(); err != nil {
+				return nil, fmt.Errorf("Error building AzureRM Client: %s", err)
+			}
+			if builder.SubscriptionID == "" {
+				return nil, fmt.Errorf("Error building AzureRM Client: A Subscription ID must be configured when authenticating as a Service Principal using an OIDC Token.")
+			}
+
+			log.Printf("[DEBUG] Using Service Principal / OIDC Token for Authentication")
+			authOptions.clientAssertion = &clientAssertion
+			config = &authentication.Config{
+				ClientID:                         builder.ClientID,
+				SubscriptionID:                   builder.SubscriptionID,
+				TenantID:                         builder.TenantID,
+				Environment:                      builder.Environment,
+				AuthenticatedAsAServicePrincipal: true,
+			}
+		} else {
+			var err error
+			config, err = builder.Build()
+			if err != nil {
+				return nil, fmt.Errorf("Error building AzureRM Client: %s", err)
+			}
 		}
 
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration, partnerId)
+		retryPolicy, err := expandProviderRetryPolicy(d.Get("retry").([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("Error building AzureRM Client: %s", err)
+		}
+		client, err := getArmClient(config, skipProviderRegistration, partnerId, retryPolicy, authOptions)
 
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.defaultTags = expandProviderDefaultTags(d.Get("default_tags").([]interface{}))
+		client.ignoredTags = azure.IgnoredTags{
+			Keys:     *utils.ExpandStringArray(d.Get("ignore_tag_keys").(*schema.Set).List()),
+			Prefixes: *utils.ExpandStringArray(d.Get("ignore_tag_prefixes").(*schema.Set).List()),
+		}
+		client.validateResourceSkus = d.Get("validate_resource_skus").(bool)
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -454,6 +678,48 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	}
 }
 
+func expandProviderAuxiliaryTenantIds(input []interface{}) []string {
+	tenantIds := make([]string, 0)
+	for _, v := range input {
+		tenantIds = append(tenantIds, v.(string))
+	}
+
+	// lists can't have a default value, so the Environment Variable is checked here
+	if len(tenantIds) == 0 {
+		for _, v := range strings.Split(os.Getenv("ARM_AUXILIARY_TENANT_IDS"), ";") {
+			if v = strings.TrimSpace(v); v != "" {
+				tenantIds = append(tenantIds, v)
+			}
+		}
+	}
+
+	return tenantIds
+}
+
+func expandProviderRetryPolicy(input []interface{}) (azure.RetryPolicy, error) {
+	policy := azure.DefaultRetryPolicy()
+	if len(input) == 0 || input[0] == nil {
+		return policy, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	policy.MaxAttempts = v["max_attempts"].(int)
+	policy.MinBackoff = time.Duration(v["min_backoff_seconds"].(int)) * time.Second
+	policy.MaxBackoff = time.Duration(v["max_backoff_seconds"].(int)) * time.Second
+	if policy.MinBackoff > policy.MaxBackoff {
+		return policy, fmt.Errorf("`min_backoff_seconds` (%d) must be less than or equal to `max_backoff_seconds` (%d)", v["min_backoff_seconds"].(int), v["max_backoff_seconds"].(int))
+	}
+
+	if codes := v["status_codes"].(*schema.Set).List(); len(codes) > 0 {
+		policy.StatusCodes = make([]int, 0)
+		for _, code := range codes {
+			policy.StatusCodes = append(policy.StatusCodes, code.(int))
+		}
+	}
+
+	return policy, nil
+}
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = mutexkv.NewMutexKV()
 
@@ -33,6 +33,47 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestExpandProviderRetryPolicy(t *testing.T) {
+	cases := []struct {
+		minBackoff  int
+		maxBackoff  int
+		expectError bool
+	}{
+		{
+			minBackoff: 2,
+			maxBackoff: 60,
+		},
+		{
+			minBackoff: 10,
+			maxBackoff: 10,
+		},
+		{
+			minBackoff:  30,
+			maxBackoff:  10,
+			expectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		input := []interface{}{
+			map[string]interface{}{
+				"max_attempts":        5,
+				"min_backoff_seconds": v.minBackoff,
+				"max_backoff_seconds": v.maxBackoff,
+				"status_codes":        schema.NewSet(schema.HashInt, []interface{}{}),
+			},
+		}
+
+		_, err := expandProviderRetryPolicy(input)
+		if v.expectError && err == nil {
+			t.Fatalf("Expected an error for a min backoff of %d and a max backoff of %d but didn't get one", v.minBackoff, v.maxBackoff)
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("Expected no error for a min backoff of %d and a max backoff of %d but got: %+v", v.minBackoff, v.maxBackoff, err)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	variables := []string{
 		"ARM_CLIENT_ID",
@@ -31,7 +31,8 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 			"requiresImport":      testAccAzureRMExpressRouteCircuitPeering_requiresImport,
 		},
 		"MicrosoftPeering": {
-			"microsoftPeering": testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"microsoftPeering":            testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"microsoftPeeringRouteFilter": testAccAzureRMExpressRouteCircuitPeering_microsoftPeeringRouteFilter,
 		},
 		"authorization": {
 			"basic":          testAccAzureRMExpressRouteCircuitAuthorization_basic,
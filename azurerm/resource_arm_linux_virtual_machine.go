@@ -0,0 +1,486 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLinuxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLinuxVirtualMachineCreate,
+		Read:   resourceArmLinuxVirtualMachineRead,
+		Update: resourceArmLinuxVirtualMachineUpdate,
+		Delete: resourceArmLinuxVirtualMachineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceid.ValidateNetworkInterfaceId,
+				},
+			},
+
+			"os_disk": virtualMachineOSDiskSchema(),
+
+			"admin_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_ssh_key": virtualMachineSSHKeysSchema(),
+
+			"allow_extension_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"availability_set_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     resourceid.ValidateAvailabilitySetId,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"zones"},
+			},
+
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
+
+			"computer_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLinuxVirtualMachineComputerName,
+			},
+
+			"custom_data": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				StateFunc: userDataStateFunc,
+			},
+
+			"disable_password_authentication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"plan": virtualMachinePlanSchema(),
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"secret": virtualMachineSecretSchema(),
+
+			"source_image_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"source_image_reference": virtualMachineSourceImageReferenceSchema(),
+
+			"zones": singleZonesSchema(),
+
+			"tags": tagsSchema(),
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLinuxVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_linux_virtual_machine", *existing.ID)
+		}
+	}
+
+	adminPassword := d.Get("admin_password").(string)
+	disablePasswordAuthentication := d.Get("disable_password_authentication").(bool)
+	sshKeys := expandVirtualMachineSSHKeys(d.Get("admin_ssh_key").(*schema.Set).List())
+	if disablePasswordAuthentication && adminPassword != "" {
+		return fmt.Errorf("`admin_password` cannot be specified when `disable_password_authentication` is set to `true`")
+	}
+	if !disablePasswordAuthentication && adminPassword == "" {
+		return fmt.Errorf("An `admin_password` must be specified when `disable_password_authentication` is set to `false`")
+	}
+	if disablePasswordAuthentication && len(sshKeys) == 0 {
+		return fmt.Errorf("At least one `admin_ssh_key` must be specified when `disable_password_authentication` is set to `true`")
+	}
+
+	imageReference, err := expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return err
+	}
+
+	computerName := d.Get("computer_name").(string)
+	if computerName == "" {
+		computerName = name
+	}
+
+	networkInterfaceIds := d.Get("network_interface_ids").([]interface{})
+	// the first Network Interface is the Primary
+	primaryNetworkInterfaceId := networkInterfaceIds[0].(string)
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	params := compute.VirtualMachine{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Identity: expandVirtualMachineIdentity(d.Get("identity").([]interface{})),
+		Plan:     expandVirtualMachinePlan(d.Get("plan").([]interface{})),
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+			},
+			OsProfile: &compute.OSProfile{
+				AdminUsername:            utils.String(d.Get("admin_username").(string)),
+				AllowExtensionOperations: utils.Bool(d.Get("allow_extension_operations").(bool)),
+				ComputerName:             utils.String(computerName),
+				LinuxConfiguration: &compute.LinuxConfiguration{
+					DisablePasswordAuthentication: utils.Bool(disablePasswordAuthentication),
+					ProvisionVMAgent:              utils.Bool(d.Get("provision_vm_agent").(bool)),
+					SSH: &compute.SSHConfiguration{
+						PublicKeys: &sshKeys,
+					},
+				},
+				Secrets: expandVirtualMachineSecrets(d.Get("secret").([]interface{})),
+			},
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: expandVirtualMachineNetworkInterfaceIDs(networkInterfaceIds, primaryNetworkInterfaceId),
+			},
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: imageReference,
+				OsDisk:         expandVirtualMachineOSDisk(d.Get("os_disk").([]interface{}), compute.Linux),
+			},
+			DiagnosticsProfile: expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+		},
+		Tags:  expandTags(d.Get("tags").(map[string]interface{}), meta),
+		Zones: expandZones(d.Get("zones").([]interface{})),
+	}
+
+	if adminPassword != "" {
+		params.VirtualMachineProperties.OsProfile.AdminPassword = utils.String(adminPassword)
+	}
+
+	if v := d.Get("custom_data").(string); v != "" {
+		params.VirtualMachineProperties.OsProfile.CustomData = utils.String(base64Encode(v))
+	}
+
+	if v := d.Get("availability_set_id").(string); v != "" {
+		params.VirtualMachineProperties.AvailabilitySet = &compute.SubResource{
+			ID: utils.String(v),
+		}
+	}
+
+	azureRMLockByName(name, virtualMachineResourceName)
+	defer azureRMUnlockByName(name, virtualMachineResourceName)
+
+	log.Printf("[DEBUG] Creating Linux Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Created Linux Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	ipAddress, err := determineVirtualMachineIPAddress(ctx, meta, read.VirtualMachineProperties)
+	if err != nil {
+		return fmt.Errorf("Error determining IP Address for Linux Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetConnInfo(map[string]string{
+		"type": "ssh",
+		"host": ipAddress,
+	})
+
+	return resourceArmLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Linux Virtual Machine %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("zones", resp.Zones)
+
+	if err := d.Set("identity", flattenAzureRmVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenAzureRmVirtualMachinePlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	props := resp.VirtualMachineProperties
+	if props == nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+
+	availabilitySetId := ""
+	if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+		availabilitySetId = *props.AvailabilitySet.ID
+	}
+	d.Set("availability_set_id", availabilitySetId)
+
+	bootDiagnostics := make([]interface{}, 0)
+	if profile := props.DiagnosticsProfile; profile != nil && profile.BootDiagnostics != nil {
+		// Boot Diagnostics are returned as disabled rather than omitted once they've been removed
+		if enabled := profile.BootDiagnostics.Enabled; enabled != nil && *enabled {
+			bootDiagnostics = flattenAzureRmVirtualMachineDiagnosticsProfile(profile.BootDiagnostics)
+		}
+	}
+	if err := d.Set("boot_diagnostics", bootDiagnostics); err != nil {
+		return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+	}
+
+	if profile := props.HardwareProfile; profile != nil {
+		d.Set("size", string(profile.VMSize))
+	}
+
+	if profile := props.NetworkProfile; profile != nil && profile.NetworkInterfaces != nil {
+		if err := d.Set("network_interface_ids", flattenAzureRmVirtualMachineNetworkInterfaces(profile)); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+	}
+
+	if profile := props.OsProfile; profile != nil {
+		d.Set("admin_username", profile.AdminUsername)
+		d.Set("computer_name", profile.ComputerName)
+
+		allowExtensionOperations := true
+		if profile.AllowExtensionOperations != nil {
+			allowExtensionOperations = *profile.AllowExtensionOperations
+		}
+		d.Set("allow_extension_operations", allowExtensionOperations)
+
+		if config := profile.LinuxConfiguration; config != nil {
+			d.Set("disable_password_authentication", config.DisablePasswordAuthentication)
+			d.Set("provision_vm_agent", config.ProvisionVMAgent)
+
+			sshKeys, err := flattenVirtualMachineSSHKeys(config.SSH)
+			if err != nil {
+				return fmt.Errorf("Error flattening `admin_ssh_key`: %+v", err)
+			}
+			if err := d.Set("admin_ssh_key", schema.NewSet(schema.HashResource(virtualMachineSSHKeysSchema().Elem.(*schema.Resource)), sshKeys)); err != nil {
+				return fmt.Errorf("Error setting `admin_ssh_key`: %+v", err)
+			}
+		}
+
+		if err := d.Set("secret", flattenAzureRmVirtualMachineOsProfileSecrets(profile.Secrets)); err != nil {
+			return fmt.Errorf("Error setting `secret`: %+v", err)
+		}
+	}
+
+	if profile := props.StorageProfile; profile != nil {
+		var diskInfo *compute.Disk
+		if profile.OsDisk != nil {
+			diskInfo, err = resourceArmVirtualMachineGetManagedDiskInfo(ctx, profile.OsDisk.ManagedDisk, meta)
+			if err != nil {
+				return fmt.Errorf("Error retrieving the OS Disk for Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+			}
+		}
+
+		if err := d.Set("os_disk", flattenVirtualMachineOSDisk(profile.OsDisk, diskInfo)); err != nil {
+			return fmt.Errorf("Error setting `os_disk`: %+v", err)
+		}
+
+		sourceImageId := ""
+		if profile.ImageReference != nil && profile.ImageReference.ID != nil {
+			sourceImageId = *profile.ImageReference.ID
+		}
+		d.Set("source_image_id", sourceImageId)
+
+		if err := d.Set("source_image_reference", flattenVirtualMachineSourceImageReference(profile.ImageReference)); err != nil {
+			return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+		}
+	}
+
+	d.Set("virtual_machine_id", props.VMID)
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmLinuxVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	update, err := expandVirtualMachineUpdate(ctx, d, meta, compute.Linux, existing)
+	if err != nil {
+		return err
+	}
+
+	if err := applyVirtualMachineUpdate(ctx, meta, id.ResourceGroup, id.Name, d.Get("os_disk.0.id").(string), *update); err != nil {
+		return err
+	}
+
+	return resourceArmLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineResourceName)
+
+	if err := deleteVirtualMachineAndOSDisk(ctx, meta, id.ResourceGroup, id.Name); err != nil {
+		return fmt.Errorf("Error deleting Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func validateLinuxVirtualMachineComputerName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if len(v) > 64 {
+		errors = append(errors, fmt.Errorf("%q can be at most 64 characters, got %d", k, len(v)))
+	}
+
+	if strings.HasPrefix(v, "_") || strings.HasSuffix(v, ".") || strings.HasSuffix(v, "-") {
+		errors = append(errors, fmt.Errorf("%q cannot begin with an underscore or end with a period or hyphen", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,526 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLinuxVirtualMachineScaleSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLinuxVirtualMachineScaleSetCreateUpdate,
+		Read:   resourceArmLinuxVirtualMachineScaleSetRead,
+		Update: resourceArmLinuxVirtualMachineScaleSetCreateUpdate,
+		Delete: resourceArmLinuxVirtualMachineScaleSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"instances": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"network_interface": virtualMachineScaleSetNetworkInterfaceSchema(),
+
+			"os_disk": virtualMachineScaleSetOSDiskSchema(),
+
+			"sku": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_ssh_key": virtualMachineSSHKeysSchema(),
+
+			"automatic_os_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
+
+			"computer_name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				// Computer Name Prefixes can be at most 58 characters, leaving space for the 6 character suffix
+				ValidateFunc: validation.StringLenBetween(1, 58),
+			},
+
+			"custom_data": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				StateFunc: userDataStateFunc,
+			},
+
+			"data_disk": virtualMachineScaleSetDataDiskSchema(),
+
+			"disable_password_authentication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"eviction_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Deallocate),
+					string(compute.Delete),
+				}, false),
+			},
+
+			"health_probe_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: resourceid.ValidateLoadBalancerProbeId,
+			},
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"instance_update_policy": virtualMachineScaleSetInstanceUpdatePolicySchema(),
+
+			"overprovision": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"plan": virtualMachinePlanSchema(),
+
+			"priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(compute.Regular),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Low),
+					string(compute.Regular),
+				}, false),
+			},
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"rolling_upgrade_policy": virtualMachineScaleSetRollingUpgradePolicySchema("upgrade_mode"),
+
+			"secret": virtualMachineSecretSchema(),
+
+			"single_placement_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"source_image_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"source_image_reference": virtualMachineSourceImageReferenceSchema(),
+
+			"upgrade_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(compute.Manual),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Automatic),
+					string(compute.Manual),
+					string(compute.Rolling),
+				}, false),
+			},
+
+			"zones": zonesSchema(),
+
+			"tags": tagsSchema(),
+
+			"unique_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLinuxVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_linux_virtual_machine_scale_set", *existing.ID)
+		}
+	}
+
+	adminPassword := d.Get("admin_password").(string)
+	disablePasswordAuthentication := d.Get("disable_password_authentication").(bool)
+	sshKeys := expandVirtualMachineSSHKeys(d.Get("admin_ssh_key").(*schema.Set).List())
+	if disablePasswordAuthentication && adminPassword != "" {
+		return fmt.Errorf("`admin_password` cannot be specified when `disable_password_authentication` is set to `true`")
+	}
+	if !disablePasswordAuthentication && adminPassword == "" {
+		return fmt.Errorf("An `admin_password` must be specified when `disable_password_authentication` is set to `false`")
+	}
+	if disablePasswordAuthentication && len(sshKeys) == 0 {
+		return fmt.Errorf("At least one `admin_ssh_key` must be specified when `disable_password_authentication` is set to `true`")
+	}
+
+	imageReference, err := expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return err
+	}
+
+	upgradePolicy, err := expandVirtualMachineScaleSetUpgradePolicy(d)
+	if err != nil {
+		return err
+	}
+
+	computerNamePrefix := d.Get("computer_name_prefix").(string)
+	if computerNamePrefix == "" {
+		computerNamePrefix = name
+	}
+
+	priority := compute.VirtualMachinePriorityTypes(d.Get("priority").(string))
+	evictionPolicy := d.Get("eviction_policy").(string)
+	if priority != compute.Low && evictionPolicy != "" {
+		return fmt.Errorf("An `eviction_policy` can only be specified when `priority` is set to `Low`")
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	params := compute.VirtualMachineScaleSet{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Identity: expandVirtualMachineScaleSetIdentity(d.Get("identity").([]interface{})),
+		Plan:     expandVirtualMachinePlan(d.Get("plan").([]interface{})),
+		Sku: &compute.Sku{
+			Name:     utils.String(d.Get("sku").(string)),
+			Capacity: utils.Int64(int64(d.Get("instances").(int))),
+
+			// doesn't appear this can be set to anything else, even Promo machines are Standard
+			Tier: utils.String("Standard"),
+		},
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			Overprovision:        utils.Bool(d.Get("overprovision").(bool)),
+			SinglePlacementGroup: utils.Bool(d.Get("single_placement_group").(bool)),
+			UpgradePolicy:        upgradePolicy,
+			VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
+				DiagnosticsProfile: expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+				NetworkProfile:     expandVirtualMachineScaleSetNetworkInterfaces(d.Get("network_interface").([]interface{}), d.Get("health_probe_id").(string)),
+				OsProfile: &compute.VirtualMachineScaleSetOSProfile{
+					AdminUsername:      utils.String(d.Get("admin_username").(string)),
+					ComputerNamePrefix: utils.String(computerNamePrefix),
+					LinuxConfiguration: &compute.LinuxConfiguration{
+						DisablePasswordAuthentication: utils.Bool(disablePasswordAuthentication),
+						ProvisionVMAgent:              utils.Bool(d.Get("provision_vm_agent").(bool)),
+						SSH: &compute.SSHConfiguration{
+							PublicKeys: &sshKeys,
+						},
+					},
+					Secrets: expandVirtualMachineSecrets(d.Get("secret").([]interface{})),
+				},
+				Priority: priority,
+				StorageProfile: &compute.VirtualMachineScaleSetStorageProfile{
+					ImageReference: imageReference,
+					OsDisk:         expandVirtualMachineScaleSetOSDisk(d.Get("os_disk").([]interface{}), compute.Linux),
+					DataDisks:      expandVirtualMachineScaleSetDataDisks(d.Get("data_disk").([]interface{})),
+				},
+			},
+		},
+		Tags:  expandTags(d.Get("tags").(map[string]interface{}), meta),
+		Zones: expandZones(d.Get("zones").([]interface{})),
+	}
+
+	if adminPassword != "" {
+		params.VirtualMachineScaleSetProperties.VirtualMachineProfile.OsProfile.AdminPassword = utils.String(adminPassword)
+	}
+
+	if v := d.Get("custom_data").(string); v != "" {
+		params.VirtualMachineScaleSetProperties.VirtualMachineProfile.OsProfile.CustomData = utils.String(base64Encode(v))
+	}
+
+	if evictionPolicy != "" {
+		params.VirtualMachineScaleSetProperties.VirtualMachineProfile.EvictionPolicy = compute.VirtualMachineEvictionPolicyTypes(evictionPolicy)
+	}
+
+	azureRMLockByName(name, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(name, virtualMachineScaleSetResourceName)
+
+	if !d.IsNewResource() {
+		extensionProfile, err := retrieveVirtualMachineScaleSetExtensionProfile(ctx, client, resourceGroup, name)
+		if err != nil {
+			return err
+		}
+		params.VirtualMachineScaleSetProperties.VirtualMachineProfile.ExtensionProfile = extensionProfile
+	}
+
+	modelUpdatedAt := time.Now()
+	log.Printf("[DEBUG] Creating/Updating Linux Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Created/Updated Linux Virtual Machine Scale Set %q (Resource Group %q).", name, resourceGroup)
+
+	if !d.IsNewResource() {
+		if policy := expandVirtualMachineScaleSetInstanceUpdatePolicy(d.Get("instance_update_policy").([]interface{})); policy != nil {
+			log.Printf("[DEBUG] Rolling out the latest model to the instances of Linux Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+			if err := rollOutVirtualMachineScaleSetModel(ctx, meta, resourceGroup, name, upgradePolicy.Mode, *policy, modelUpdatedAt, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+			log.Printf("[DEBUG] Rolled out the latest model to the instances of Linux Virtual Machine Scale Set %q (Resource Group %q).", name, resourceGroup)
+		}
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine Scale Set %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLinuxVirtualMachineScaleSetRead(d, meta)
+}
+
+func resourceArmLinuxVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineScaleSetId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Linux Virtual Machine Scale Set %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("zones", resp.Zones)
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", sku.Name)
+
+		instances := 0
+		if sku.Capacity != nil {
+			instances = int(*sku.Capacity)
+		}
+		d.Set("instances", instances)
+	}
+
+	if err := d.Set("identity", flattenAzureRmVirtualMachineScaleSetIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenAzureRmVirtualMachinePlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	props := resp.VirtualMachineScaleSetProperties
+	if props == nil {
+		return fmt.Errorf("Error retrieving Linux Virtual Machine Scale Set %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+
+	d.Set("overprovision", props.Overprovision)
+	d.Set("single_placement_group", props.SinglePlacementGroup)
+	d.Set("unique_id", props.UniqueID)
+
+	rollingUpgradePolicy := make([]interface{}, 0)
+	if policy := props.UpgradePolicy; policy != nil {
+		d.Set("upgrade_mode", string(policy.Mode))
+		d.Set("automatic_os_upgrade", policy.AutomaticOSUpgrade)
+
+		// the API returns the default Rolling Upgrade Policy when another Upgrade Mode is used
+		if policy.Mode == compute.Rolling && policy.RollingUpgradePolicy != nil {
+			rollingUpgradePolicy = flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(policy.RollingUpgradePolicy)
+		}
+	}
+	if err := d.Set("rolling_upgrade_policy", rollingUpgradePolicy); err != nil {
+		return fmt.Errorf("Error setting `rolling_upgrade_policy`: %+v", err)
+	}
+
+	if profile := props.VirtualMachineProfile; profile != nil {
+		d.Set("priority", string(profile.Priority))
+		d.Set("eviction_policy", string(profile.EvictionPolicy))
+
+		bootDiagnostics := make([]interface{}, 0)
+		if profile.DiagnosticsProfile != nil && profile.DiagnosticsProfile.BootDiagnostics != nil {
+			if enabled := profile.DiagnosticsProfile.BootDiagnostics.Enabled; enabled != nil && *enabled {
+				bootDiagnostics = flattenAzureRmVirtualMachineDiagnosticsProfile(profile.DiagnosticsProfile.BootDiagnostics)
+			}
+		}
+		if err := d.Set("boot_diagnostics", bootDiagnostics); err != nil {
+			return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+		}
+
+		healthProbeId := ""
+		if profile.NetworkProfile != nil && profile.NetworkProfile.HealthProbe != nil && profile.NetworkProfile.HealthProbe.ID != nil {
+			healthProbeId = *profile.NetworkProfile.HealthProbe.ID
+		}
+		d.Set("health_probe_id", healthProbeId)
+
+		if err := d.Set("network_interface", flattenVirtualMachineScaleSetNetworkInterfaces(profile.NetworkProfile)); err != nil {
+			return fmt.Errorf("Error setting `network_interface`: %+v", err)
+		}
+
+		if osProfile := profile.OsProfile; osProfile != nil {
+			// admin_password and custom_data aren't returned by the API
+			d.Set("admin_username", osProfile.AdminUsername)
+			d.Set("computer_name_prefix", osProfile.ComputerNamePrefix)
+
+			if config := osProfile.LinuxConfiguration; config != nil {
+				d.Set("disable_password_authentication", config.DisablePasswordAuthentication)
+				d.Set("provision_vm_agent", config.ProvisionVMAgent)
+
+				sshKeys, err := flattenVirtualMachineSSHKeys(config.SSH)
+				if err != nil {
+					return fmt.Errorf("Error flattening `admin_ssh_key`: %+v", err)
+				}
+				if err := d.Set("admin_ssh_key", schema.NewSet(schema.HashResource(virtualMachineSSHKeysSchema().Elem.(*schema.Resource)), sshKeys)); err != nil {
+					return fmt.Errorf("Error setting `admin_ssh_key`: %+v", err)
+				}
+			}
+
+			if err := d.Set("secret", flattenAzureRmVirtualMachineOsProfileSecrets(osProfile.Secrets)); err != nil {
+				return fmt.Errorf("Error setting `secret`: %+v", err)
+			}
+		}
+
+		if storageProfile := profile.StorageProfile; storageProfile != nil {
+			if err := d.Set("os_disk", flattenVirtualMachineScaleSetOSDisk(storageProfile.OsDisk)); err != nil {
+				return fmt.Errorf("Error setting `os_disk`: %+v", err)
+			}
+
+			if err := d.Set("data_disk", flattenVirtualMachineScaleSetDataDisks(storageProfile.DataDisks)); err != nil {
+				return fmt.Errorf("Error setting `data_disk`: %+v", err)
+			}
+
+			sourceImageId := ""
+			if storageProfile.ImageReference != nil && storageProfile.ImageReference.ID != nil {
+				sourceImageId = *storageProfile.ImageReference.ID
+			}
+			d.Set("source_image_id", sourceImageId)
+
+			if err := d.Set("source_image_reference", flattenVirtualMachineSourceImageReference(storageProfile.ImageReference)); err != nil {
+				return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+			}
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmLinuxVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineScaleSetId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineScaleSetResourceName)
+
+	log.Printf("[DEBUG] Deleting Linux Virtual Machine Scale Set %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Linux Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Deleted Linux Virtual Machine Scale Set %q (Resource Group %q).", id.Name, id.ResourceGroup)
+
+	return nil
+}
@@ -46,6 +46,35 @@ func TestAccAzureRMLoadBalancerOutboundRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLoadBalancerOutboundRule_publicIpPrefix(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := tf.AccRandTimeInt()
+	outboundRuleName := fmt.Sprintf("OutboundRule-%d", ri)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancerOutboundRule_publicIpPrefix(ri, outboundRuleName, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerOutboundRuleExists(outboundRuleName, &lb),
+					resource.TestCheckResourceAttrSet("azurerm_lb.test", "frontend_ip_configuration.0.public_ip_prefix_id"),
+				),
+			},
+			{
+				ResourceName:      "azurerm_lb.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// location is deprecated and was never actually used
+				ImportStateVerifyIgnore: []string{"location"},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMLoadBalancerOutboundRule_requiresImport(t *testing.T) {
 	if !requireResourcesToBeImported {
 		t.Skip("Skipping since resources aren't required to be imported")
@@ -304,6 +333,52 @@ resource "azurerm_lb_outbound_rule" "test" {
 `, rInt, location, rInt, rInt, rInt, rInt, outboundRuleName, rInt)
 }
 
+func testAccAzureRMLoadBalancerOutboundRule_publicIpPrefix(rInt int, outboundRuleName string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "test-ip-prefix-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  prefix_length       = 31
+}
+
+resource "azurerm_lb" "test" {
+  name                = "arm-test-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                = "one-%d"
+    public_ip_prefix_id = "${azurerm_public_ip_prefix.test.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+  name                = "be-%d"
+}
+
+resource "azurerm_lb_outbound_rule" "test" {
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  loadbalancer_id         = "${azurerm_lb.test.id}"
+  name                    = "%s"
+  backend_address_pool_id = "${azurerm_lb_backend_address_pool.test.id}"
+  protocol                = "All"
+
+  frontend_ip_configuration {
+    name = "one-%d"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, outboundRuleName, rInt)
+}
+
 func testAccAzureRMLoadBalancerOutboundRule_requiresImport(rInt int, name string, location string) string {
 	template := testAccAzureRMLoadBalancerOutboundRule_basic(rInt, name, location)
 	return fmt.Sprintf(`
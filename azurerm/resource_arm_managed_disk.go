@@ -1,16 +1,21 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -25,6 +30,15 @@ func resourceArmManagedDisk() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: validateResourceSkuAvailabilityDiff("disks", "storage_account_type"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -99,6 +113,12 @@ func resourceArmManagedDisk() *schema.Resource {
 
 			"encryption_settings": encryptionSettingsSchema(),
 
+			"allow_virtual_machine_deallocation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -115,7 +135,8 @@ func validateDiskSizeGB(v interface{}, _ string) (warnings []string, errors []er
 
 func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Managed Disk creation.")
 
@@ -139,7 +160,7 @@ func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}
 	storageAccountType := d.Get("storage_account_type").(string)
 	osType := d.Get("os_type").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(tags, meta)
 	zones := expandZones(d.Get("zones").([]interface{}))
 
 	var skuName compute.DiskStorageAccountTypes
@@ -202,12 +223,53 @@ func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}
 		createDisk.EncryptionSettings = expandManagedDiskEncryptionSettings(settings)
 	}
 
+	// the size and type of a Managed Disk can only be changed when it's not attached to a running Virtual Machine
+	var deallocatedVirtualMachine *resourceid.VirtualMachineId
+	shouldStartVirtualMachine := false
+	if !d.IsNewResource() && (d.HasChange("disk_size_gb") || d.HasChange("storage_account_type")) {
+		if d.HasChange("disk_size_gb") {
+			oldSize, newSize := d.GetChange("disk_size_gb")
+			if newSize.(int) != 0 && newSize.(int) < oldSize.(int) {
+				return fmt.Errorf("The size of Managed Disk %q (Resource Group %q) can only be increased - (from %d GB to %d GB)", name, resGroup, oldSize.(int), newSize.(int))
+			}
+		}
+
+		virtualMachineId, powerState, err := retrieveManagedDiskAttachedVirtualMachine(ctx, meta, resGroup, name)
+		if err != nil {
+			return err
+		}
+
+		if virtualMachineId != nil && powerState != "deallocated" {
+			if !d.Get("allow_virtual_machine_deallocation").(bool) {
+				return fmt.Errorf("Error updating Managed Disk %q (Resource Group %q): the `disk_size_gb` and `storage_account_type` can only be changed when the Managed Disk isn't attached to a running Virtual Machine - it's attached to Virtual Machine %q (Resource Group %q) which has the Power State %q. Either deallocate the Virtual Machine first, or set `allow_virtual_machine_deallocation` to `true` to deallocate it (and start it again) during the update", name, resGroup, virtualMachineId.Name, virtualMachineId.ResourceGroup, powerState)
+			}
+
+			azureRMLockByName(virtualMachineId.Name, virtualMachineResourceName)
+			defer azureRMUnlockByName(virtualMachineId.Name, virtualMachineResourceName)
+
+			if err := deallocateVirtualMachine(ctx, meta.(*ArmClient).vmClient, virtualMachineId.ResourceGroup, virtualMachineId.Name); err != nil {
+				return err
+			}
+
+			deallocatedVirtualMachine = virtualMachineId
+			// a Virtual Machine which was Stopped (but not deallocated) is left deallocated, since it wasn't running
+			shouldStartVirtualMachine = powerState == "running" || powerState == "starting"
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, createDisk)
-	if err != nil {
-		return err
+	if err == nil {
+		err = future.WaitForCompletionRef(ctx, client.Client)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	// the Virtual Machine is started again even if the update failed, so that it's not left deallocated
+	if deallocatedVirtualMachine != nil && shouldStartVirtualMachine {
+		if startErr := startVirtualMachine(ctx, meta.(*ArmClient).vmClient, deallocatedVirtualMachine.ResourceGroup, deallocatedVirtualMachine.Name); startErr != nil {
+			err = multierror.Append(err, startErr)
+		}
+	}
+
+	if err != nil {
 		return err
 	}
 
@@ -226,7 +288,8 @@ func resourceArmManagedDiskCreateUpdate(d *schema.ResourceData, meta interface{}
 
 func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -276,14 +339,15 @@ func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -320,3 +384,32 @@ func flattenAzureRmManagedDiskCreationData(d *schema.ResourceData, creationData
 		d.Set("source_uri", *creationData.SourceURI)
 	}
 }
+
+// retrieveManagedDiskAttachedVirtualMachine returns the ID and Power State of the Virtual Machine which the Managed
+// Disk is attached to - or nil if the Managed Disk isn't attached to a Virtual Machine
+func retrieveManagedDiskAttachedVirtualMachine(ctx context.Context, meta interface{}, resourceGroup, name string) (*resourceid.VirtualMachineId, string, error) {
+	client := meta.(*ArmClient).diskClient
+	vmClient := meta.(*ArmClient).vmClient
+
+	disk, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return nil, "", fmt.Errorf("Error retrieving Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if disk.ManagedBy == nil || *disk.ManagedBy == "" {
+		return nil, "", nil
+	}
+
+	// Managed Disks attached to a Virtual Machine Scale Set instance can only be changed via the Scale Set
+	virtualMachineId, err := resourceid.ParseVirtualMachineId(*disk.ManagedBy)
+	if err != nil {
+		return nil, "", fmt.Errorf("Error updating Managed Disk %q (Resource Group %q): the Managed Disk is attached to %q which isn't a Virtual Machine that can be deallocated - detach the Managed Disk before changing the `disk_size_gb` or `storage_account_type`", name, resourceGroup, *disk.ManagedBy)
+	}
+
+	powerState, err := retrieveVirtualMachinePowerState(ctx, vmClient, virtualMachineId.ResourceGroup, virtualMachineId.Name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return virtualMachineId, powerState, nil
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
@@ -33,6 +34,10 @@ func TestAccAzureRMManagedDisk_empty(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"allow_virtual_machine_deallocation",
+				},
 			},
 		},
 	})
@@ -270,6 +275,48 @@ func TestAccAzureRMManagedDisk_importEmpty_withZone(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"allow_virtual_machine_deallocation",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedDisk_attachedToVirtualMachine(t *testing.T) {
+	resourceName := "azurerm_managed_disk.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	var d compute.Disk
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedDisk_attachedToVirtualMachine(ri, location, 10, "Standard_LRS", false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists(resourceName, &d, true),
+					resource.TestCheckResourceAttr(resourceName, "disk_size_gb", "10"),
+				),
+			},
+			{
+				Config:      testAccAzureRMManagedDisk_attachedToVirtualMachine(ri, location, 20, "Standard_LRS", false),
+				ExpectError: regexp.MustCompile("can only be changed when the Managed Disk isn't attached to a running Virtual Machine"),
+			},
+			{
+				Config: testAccAzureRMManagedDisk_attachedToVirtualMachine(ri, location, 20, "StandardSSD_LRS", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists(resourceName, &d, true),
+					resource.TestCheckResourceAttr(resourceName, "disk_size_gb", "20"),
+					resource.TestCheckResourceAttr(resourceName, "storage_account_type", "StandardSSD_LRS"),
+				),
+			},
+			{
+				Config:      testAccAzureRMManagedDisk_attachedToVirtualMachine(ri, location, 10, "StandardSSD_LRS", true),
+				ExpectError: regexp.MustCompile("can only be increased"),
 			},
 		},
 	})
@@ -700,3 +747,87 @@ resource "azurerm_managed_disk" "test" {
 }
 `, rInt, location, rString, rString, rString, rInt)
 }
+
+func testAccAzureRMManagedDisk_attachedToVirtualMachine(rInt int, location string, diskSizeGB int, storageAccountType string, allowDeallocation bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_F2"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "myosdisk1"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                               = "acctestd-%d"
+  location                           = "${azurerm_resource_group.test.location}"
+  resource_group_name                = "${azurerm_resource_group.test.name}"
+  storage_account_type               = "%s"
+  create_option                      = "Empty"
+  disk_size_gb                       = %d
+  allow_virtual_machine_deallocation = %t
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = "${azurerm_managed_disk.test.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "0"
+  caching            = "None"
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt, storageAccountType, diskSizeGB, allowDeallocation)
+}
@@ -0,0 +1,424 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var networkWatcherResourceName = "azurerm_network_watcher"
+
+// Flow Logs aren't a standalone resource in the Network API - instead they're configured on the Network Watcher
+// for a given Network Security Group, so the ID is made up of the Network Watcher ID and the NSG ID in the format
+// `{networkWatcherId}/networkSecurityGroupId{networkSecurityGroupId}`. Since this embeds a second Resource ID it
+// can't be described by the `resourceid` generator, and is only used by this resource.
+type networkWatcherFlowLogId struct {
+	ResourceGroup          string
+	NetworkWatcherName     string
+	NetworkSecurityGroupId string
+}
+
+func (id networkWatcherFlowLogId) String(subscriptionId string) string {
+	watcherId := resourceid.NewNetworkWatcherId(subscriptionId, id.ResourceGroup, id.NetworkWatcherName)
+	return fmt.Sprintf("%s/networkSecurityGroupId%s", watcherId.String(), id.NetworkSecurityGroupId)
+}
+
+func parseNetworkWatcherFlowLogId(input string) (*networkWatcherFlowLogId, error) {
+	parts := strings.Split(input, "/networkSecurityGroupId")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Error parsing Network Watcher Flow Log ID %q: expected the format `{networkWatcherId}/networkSecurityGroupId{networkSecurityGroupId}`", input)
+	}
+
+	watcherId, err := resourceid.ParseNetworkWatcherId(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Network Watcher Flow Log ID %q: %+v", input, err)
+	}
+
+	if _, err := resourceid.ParseNetworkSecurityGroupId(parts[1]); err != nil {
+		return nil, fmt.Errorf("Error parsing Network Watcher Flow Log ID %q: %+v", input, err)
+	}
+
+	return &networkWatcherFlowLogId{
+		ResourceGroup:          watcherId.ResourceGroup,
+		NetworkWatcherName:     watcherId.Name,
+		NetworkSecurityGroupId: parts[1],
+	}, nil
+}
+
+func resourceArmNetworkWatcherFlowLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Read:   resourceArmNetworkWatcherFlowLogRead,
+		Update: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Delete: resourceArmNetworkWatcherFlowLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"network_security_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateNetworkSecurityGroupId,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceid.ValidateStorageAccountId,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"traffic_analytics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"workspace_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+
+						"workspace_region": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azureRMNormalizeLocation,
+							DiffSuppressFunc: azureRMSuppressLocationDiff,
+						},
+
+						"workspace_resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: resourceid.ValidateLogAnalyticsWorkspaceId,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	id := networkWatcherFlowLogId{
+		ResourceGroup:          d.Get("resource_group_name").(string),
+		NetworkWatcherName:     d.Get("network_watcher_name").(string),
+		NetworkSecurityGroupId: d.Get("network_security_group_id").(string),
+	}
+
+	azureRMLockByName(id.NetworkWatcherName, networkWatcherResourceName)
+	defer azureRMUnlockByName(id.NetworkWatcherName, networkWatcherResourceName)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := retrieveNetworkWatcherFlowLog(ctx, client, id)
+		if err != nil {
+			return err
+		}
+
+		if existing.FlowLogProperties != nil && existing.FlowLogProperties.Enabled != nil && *existing.FlowLogProperties.Enabled {
+			return tf.ImportAsExistsError("azurerm_network_watcher_flow_log", id.String(subscriptionId))
+		}
+	}
+
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(id.NetworkSecurityGroupId),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID:       utils.String(d.Get("storage_account_id").(string)),
+			Enabled:         utils.Bool(d.Get("enabled").(bool)),
+			RetentionPolicy: expandNetworkWatcherFlowLogRetentionPolicy(d.Get("retention_policy").([]interface{})),
+		},
+		FlowAnalyticsConfiguration: expandNetworkWatcherFlowLogTrafficAnalytics(d.Get("traffic_analytics").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Configuring Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q)..", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup)
+	future, err := client.SetFlowLogConfiguration(ctx, id.ResourceGroup, id.NetworkWatcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error configuring Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for configuration of Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Configured Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q).", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup)
+
+	d.SetId(id.String(subscriptionId))
+
+	return resourceArmNetworkWatcherFlowLogRead(d, meta)
+}
+
+func resourceArmNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseNetworkWatcherFlowLogId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	watcher, err := client.Get(ctx, id.ResourceGroup, id.NetworkWatcherName)
+	if err != nil {
+		if utils.ResponseWasNotFound(watcher.Response) {
+			log.Printf("[DEBUG] Network Watcher %q (Resource Group %q) was not found - removing Flow Log from state!", id.NetworkWatcherName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): %+v", id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	resp, err := retrieveNetworkWatcherFlowLog(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	// Flow Logs can't be deleted, only disabled - so if it's been disabled outside of Terraform we treat it as removed
+	props := resp.FlowLogProperties
+	if (props == nil || props.Enabled == nil || !*props.Enabled) && d.Get("enabled").(bool) {
+		log.Printf("[DEBUG] Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) is disabled - removing from state!", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_watcher_name", id.NetworkWatcherName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("network_security_group_id", id.NetworkSecurityGroupId)
+
+	if props != nil {
+		d.Set("storage_account_id", props.StorageID)
+		d.Set("enabled", props.Enabled)
+
+		if err := d.Set("retention_policy", flattenNetworkWatcherFlowLogRetentionPolicy(props.RetentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `retention_policy`: %+v", err)
+		}
+	}
+
+	if err := d.Set("traffic_analytics", flattenNetworkWatcherFlowLogTrafficAnalytics(resp.FlowAnalyticsConfiguration)); err != nil {
+		return fmt.Errorf("Error setting `traffic_analytics`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkWatcherFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseNetworkWatcherFlowLogId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.NetworkWatcherName, networkWatcherResourceName)
+	defer azureRMUnlockByName(id.NetworkWatcherName, networkWatcherResourceName)
+
+	existing, err := retrieveNetworkWatcherFlowLog(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	// Flow Logs can't be deleted - instead we disable both the Flow Log and Traffic Analytics
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(id.NetworkSecurityGroupId),
+		FlowLogProperties: &network.FlowLogProperties{
+			Enabled: utils.Bool(false),
+		},
+	}
+
+	if props := existing.FlowLogProperties; props != nil {
+		parameters.FlowLogProperties.StorageID = props.StorageID
+		parameters.FlowLogProperties.RetentionPolicy = props.RetentionPolicy
+	}
+
+	if analytics := existing.FlowAnalyticsConfiguration; analytics != nil && analytics.NetworkWatcherFlowAnalyticsConfiguration != nil {
+		analytics.NetworkWatcherFlowAnalyticsConfiguration.Enabled = utils.Bool(false)
+		parameters.FlowAnalyticsConfiguration = analytics
+	}
+
+	log.Printf("[DEBUG] Disabling Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q)..", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup)
+	future, err := client.SetFlowLogConfiguration(ctx, id.ResourceGroup, id.NetworkWatcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error disabling Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) to be disabled: %+v", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Disabled Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q).", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup)
+
+	return nil
+}
+
+func retrieveNetworkWatcherFlowLog(ctx context.Context, client network.WatchersClient, id networkWatcherFlowLogId) (*network.FlowLogInformation, error) {
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(id.NetworkSecurityGroupId),
+	}
+
+	future, err := client.GetFlowLogStatus(ctx, id.ResourceGroup, id.NetworkWatcherName, parameters)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("Error waiting for retrieval of Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	resp, err := future.Result(client)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", id.NetworkSecurityGroupId, id.NetworkWatcherName, id.ResourceGroup, err)
+	}
+
+	return &resp, nil
+}
+
+func expandNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *network.RetentionPolicyParameters {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &network.RetentionPolicyParameters{
+		Enabled: utils.Bool(raw["enabled"].(bool)),
+		Days:    utils.Int32(int32(raw["days"].(int))),
+	}
+}
+
+func flattenNetworkWatcherFlowLogRetentionPolicy(input *network.RetentionPolicyParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	days := 0
+	if input.Days != nil {
+		days = int(*input.Days)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": enabled,
+			"days":    days,
+		},
+	}
+}
+
+func expandNetworkWatcherFlowLogTrafficAnalytics(input []interface{}) *network.TrafficAnalyticsProperties {
+	if len(input) == 0 {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &network.TrafficAnalyticsProperties{
+		NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+			Enabled:             utils.Bool(raw["enabled"].(bool)),
+			WorkspaceID:         utils.String(raw["workspace_id"].(string)),
+			WorkspaceRegion:     utils.String(azureRMNormalizeLocation(raw["workspace_region"].(string))),
+			WorkspaceResourceID: utils.String(raw["workspace_resource_id"].(string)),
+		},
+	}
+}
+
+func flattenNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficAnalyticsProperties) []interface{} {
+	if input == nil || input.NetworkWatcherFlowAnalyticsConfiguration == nil {
+		return []interface{}{}
+	}
+
+	config := input.NetworkWatcherFlowAnalyticsConfiguration
+
+	// when Traffic Analytics has never been configured the API returns an empty block
+	if config.WorkspaceID == nil || *config.WorkspaceID == "" {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if config.Enabled != nil {
+		enabled = *config.Enabled
+	}
+
+	workspaceRegion := ""
+	if config.WorkspaceRegion != nil {
+		workspaceRegion = azureRMNormalizeLocation(*config.WorkspaceRegion)
+	}
+
+	workspaceResourceId := ""
+	if config.WorkspaceResourceID != nil {
+		workspaceResourceId = *config.WorkspaceResourceID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":               enabled,
+			"workspace_id":          *config.WorkspaceID,
+			"workspace_region":      workspaceRegion,
+			"workspace_resource_id": workspaceResourceId,
+		},
+	}
+}
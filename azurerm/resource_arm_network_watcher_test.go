@@ -45,6 +45,12 @@ func TestAccAzureRMNetworkWatcher(t *testing.T) {
 			"withFilters":                testAccAzureRMPacketCapture_withFilters,
 			"requiresImport":             testAccAzureRMPacketCapture_requiresImport,
 		},
+		"FlowLog": {
+			"basic":            testAccAzureRMNetworkWatcherFlowLog_basic,
+			"requiresImport":   testAccAzureRMNetworkWatcherFlowLog_requiresImport,
+			"disabled":         testAccAzureRMNetworkWatcherFlowLog_disabled,
+			"trafficAnalytics": testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics,
+		},
 	}
 
 	for group, m := range testCases {
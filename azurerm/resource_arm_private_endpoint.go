@@ -0,0 +1,297 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Private Endpoints are exposed as Interface Endpoints in this version of the Network API
+func resourceArmPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateEndpointCreateUpdate,
+		Read:   resourceArmPrivateEndpointRead,
+		Update: resourceArmPrivateEndpointCreateUpdate,
+		Delete: resourceArmPrivateEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateSubnetId,
+			},
+
+			"private_connection_resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"fqdn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"private_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateEndpointClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_endpoint", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := network.InterfaceEndpoint{
+		Location: utils.String(location),
+		InterfaceEndpointProperties: &network.InterfaceEndpointProperties{
+			EndpointService: &network.EndpointService{
+				ID: utils.String(d.Get("private_connection_resource_id").(string)),
+			},
+			Subnet: &network.Subnet{
+				ID: utils.String(d.Get("subnet_id").(string)),
+			},
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	if v := d.Get("fqdn").(string); v != "" {
+		parameters.InterfaceEndpointProperties.Fqdn = utils.String(v)
+	}
+
+	log.Printf("[DEBUG] Creating/Updating Private Endpoint %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Created/Updated Private Endpoint %q (Resource Group %q).", name, resourceGroup)
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Private Endpoint %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateEndpointRead(d, meta)
+}
+
+func resourceArmPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateEndpointClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateEndpointId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Private Endpoint %q (Resource Group %q) was not found - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.InterfaceEndpointProperties; props != nil {
+		if err := setPrivateEndpointProperties(ctx, d, meta.(*ArmClient).ifaceClient, props); err != nil {
+			return fmt.Errorf("Error setting properties for Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateEndpointClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateEndpointId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Private Endpoint %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+	log.Printf("[DEBUG] Deleted Private Endpoint %q (Resource Group %q).", id.Name, id.ResourceGroup)
+
+	return nil
+}
+
+// setPrivateEndpointProperties sets the fields shared between the Private Endpoint Resource and Data Source
+func setPrivateEndpointProperties(ctx context.Context, d *schema.ResourceData, ifaceClient network.InterfacesClient, props *network.InterfaceEndpointProperties) error {
+	privateConnectionResourceId := ""
+	if props.EndpointService != nil && props.EndpointService.ID != nil {
+		privateConnectionResourceId = *props.EndpointService.ID
+	}
+	d.Set("private_connection_resource_id", privateConnectionResourceId)
+
+	subnetId := ""
+	if props.Subnet != nil && props.Subnet.ID != nil {
+		subnetId = *props.Subnet.ID
+	}
+	d.Set("subnet_id", subnetId)
+	d.Set("fqdn", props.Fqdn)
+
+	networkInterfaceIds := flattenPrivateEndpointNetworkInterfaceIds(props.NetworkInterfaces)
+	if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+		return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+	}
+
+	// the Network Interfaces returned from the API only contain the ID, so we need to look them up to find the Private IP's
+	privateIPAddresses := make([]interface{}, 0)
+	for _, v := range networkInterfaceIds {
+		nicId, err := resourceid.ParseNetworkInterfaceId(v.(string))
+		if err != nil {
+			return err
+		}
+
+		nic, err := ifaceClient.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicId.Name, nicId.ResourceGroup, err)
+		}
+
+		privateIPAddresses = append(privateIPAddresses, flattenPrivateEndpointPrivateIPAddresses(nic.InterfacePropertiesFormat)...)
+	}
+	if err := d.Set("private_ip_addresses", privateIPAddresses); err != nil {
+		return fmt.Errorf("Error setting `private_ip_addresses`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenPrivateEndpointNetworkInterfaceIds(input *[]network.Interface) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.ID != nil {
+			results = append(results, *v.ID)
+		}
+	}
+
+	return results
+}
+
+func flattenPrivateEndpointPrivateIPAddresses(input *network.InterfacePropertiesFormat) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.IPConfigurations == nil {
+		return results
+	}
+
+	for _, config := range *input.IPConfigurations {
+		if props := config.InterfaceIPConfigurationPropertiesFormat; props != nil && props.PrivateIPAddress != nil {
+			results = append(results, *props.PrivateIPAddress)
+		}
+	}
+
+	return results
+}
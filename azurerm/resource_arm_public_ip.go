@@ -4,13 +4,16 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -36,6 +39,13 @@ func resourceArmPublicIp() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -126,6 +136,13 @@ func resourceArmPublicIp() *schema.Resource {
 				Computed: true,
 			},
 
+			"public_ip_prefix_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidatePublicIPPrefixId,
+			},
+
 			"zones": singleZonesSchema(),
 
 			"tags": tagsSchema(),
@@ -135,7 +152,8 @@ func resourceArmPublicIp() *schema.Resource {
 
 func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Public IP creation.")
 
@@ -169,6 +187,11 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	publicIpPrefixId := d.Get("public_ip_prefix_id").(string)
+	if publicIpPrefixId != "" && !strings.EqualFold(sku, "standard") {
+		return fmt.Errorf("A Standard SKU must be used when allocating a Public IP from a Public IP Prefix.")
+	}
+
 	if requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
@@ -193,10 +216,16 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 			PublicIPAddressVersion:   ipVersion,
 			IdleTimeoutInMinutes:     utils.Int32(int32(idleTimeout)),
 		},
-		Tags:  expandTags(tags),
+		Tags:  expandTags(tags, meta),
 		Zones: zones,
 	}
 
+	if publicIpPrefixId != "" {
+		publicIp.PublicIPAddressPropertiesFormat.PublicIPPrefix = &network.SubResource{
+			ID: utils.String(publicIpPrefixId),
+		}
+	}
+
 	dnl, dnlOk := d.GetOk("domain_name_label")
 	rfqdn, rfqdnOk := d.GetOk("reverse_fqdn")
 
@@ -238,7 +267,8 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -281,16 +311,23 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 		d.Set("ip_address", props.IPAddress)
 		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+
+		publicIpPrefixId := ""
+		if prefix := props.PublicIPPrefix; prefix != nil && prefix.ID != nil {
+			publicIpPrefixId = *prefix.ID
+		}
+		d.Set("public_ip_prefix_id", publicIpPrefixId)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -0,0 +1,203 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPublicIpPrefix_basic(t *testing.T) {
+	resourceName := "azurerm_public_ip_prefix.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPublicIpPrefix_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpPrefixExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "prefix_length", "28"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_prefix"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPublicIpPrefix_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_public_ip_prefix.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPublicIpPrefix_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpPrefixExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPublicIpPrefix_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_public_ip_prefix"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPublicIpPrefix_complete(t *testing.T) {
+	resourceName := "azurerm_public_ip_prefix.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIpPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPublicIpPrefix_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIpPrefixExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "prefix_length", "30"),
+					resource.TestCheckResourceAttr(resourceName, "zones.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPublicIpPrefixExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParsePublicIPPrefixId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).publicIPPrefixClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Public IP Prefix %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on publicIPPrefixClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPublicIpPrefixDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).publicIPPrefixClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_public_ip_prefix" {
+			continue
+		}
+
+		id, err := resourceid.ParsePublicIPPrefixId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Public IP Prefix still exists:\n%#v", resp.PublicIPPrefixPropertiesFormat)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPublicIpPrefix_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctestpublicipprefix-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMPublicIpPrefix_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPublicIpPrefix_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip_prefix" "import" {
+  name                = "${azurerm_public_ip_prefix.test.name}"
+  location            = "${azurerm_public_ip_prefix.test.location}"
+  resource_group_name = "${azurerm_public_ip_prefix.test.resource_group_name}"
+}
+`, template)
+}
+
+func testAccAzureRMPublicIpPrefix_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctestpublicipprefix-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  prefix_length       = 30
+  zones               = ["1"]
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, rInt, location, rInt)
+}
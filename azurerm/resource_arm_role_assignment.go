@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
 	"github.com/hashicorp/go-uuid"
@@ -26,6 +28,12 @@ func resourceArmRoleAssignment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -68,13 +76,15 @@ func resourceArmRoleAssignment() *schema.Resource {
 }
 
 func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
-	roleAssignmentsClient := meta.(*ArmClient).roleAssignmentsClient
-	roleDefinitionsClient := meta.(*ArmClient).roleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
-
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
 
+	armClient := meta.(*ArmClient).forSubscription(subscriptionIdFromScope(scope))
+	roleAssignmentsClient := armClient.roleAssignmentsClient
+	roleDefinitionsClient := armClient.roleDefinitionsClient
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
+
 	var roleDefinitionId string
 	if v, ok := d.GetOk("role_definition_id"); ok {
 		roleDefinitionId = v.(string)
@@ -124,7 +134,7 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 		},
 	}
 
-	if err := resource.Retry(300*time.Second, retryRoleAssignmentsClient(scope, name, properties, meta)); err != nil {
+	if err := resource.Retry(300*time.Second, retryRoleAssignmentsClient(ctx, scope, name, properties, armClient)); err != nil {
 		return err
 	}
 
@@ -141,9 +151,11 @@ func resourceArmRoleAssignmentCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).roleAssignmentsClient
-	roleDefinitionsClient := meta.(*ArmClient).roleDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient := meta.(*ArmClient).forSubscription(subscriptionIdFromScope(d.Id()))
+	client := armClient.roleAssignmentsClient
+	roleDefinitionsClient := armClient.roleDefinitionsClient
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	resp, err := client.GetByID(ctx, d.Id())
 	if err != nil {
@@ -180,13 +192,14 @@ func resourceArmRoleAssignmentRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceArmRoleAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).roleAssignmentsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseRoleAssignmentId(d.Id())
 	if err != nil {
 		return err
 	}
+	client := meta.(*ArmClient).forSubscription(subscriptionIdFromScope(id.scope)).roleAssignmentsClient
 
 	resp, err := client.Delete(ctx, id.scope, id.name)
 	if err != nil {
@@ -210,10 +223,9 @@ func validateRoleDefinitionName(i interface{}, k string) ([]string, []error) {
 	return nil, nil
 }
 
-func retryRoleAssignmentsClient(scope string, name string, properties authorization.RoleAssignmentCreateParameters, meta interface{}) func() *resource.RetryError {
+func retryRoleAssignmentsClient(ctx context.Context, scope string, name string, properties authorization.RoleAssignmentCreateParameters, client *ArmClient) func() *resource.RetryError {
 	return func() *resource.RetryError {
-		roleAssignmentsClient := meta.(*ArmClient).roleAssignmentsClient
-		ctx := meta.(*ArmClient).StopContext
+		roleAssignmentsClient := client.roleAssignmentsClient
 
 		resp, err := roleAssignmentsClient.Create(ctx, scope, name, properties)
 		if err != nil {
@@ -249,3 +261,13 @@ func parseRoleAssignmentId(input string) (*roleAssignmentId, error) {
 	}
 	return &id, nil
 }
+
+// subscriptionIdFromScope returns the ID of the Subscription which the Scope is within, if any
+func subscriptionIdFromScope(scope string) string {
+	segments := strings.Split(strings.TrimPrefix(scope, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return ""
+	}
+
+	return segments[1]
+}
@@ -1,13 +1,18 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -22,6 +27,13 @@ func resourceArmSharedImageVersion() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -80,6 +92,19 @@ func resourceArmSharedImageVersion() *schema.Resource {
 				Default:  false,
 			},
 
+			"wait_for_replication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"replication_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"regional_replication_status": sharedImageVersionRegionalReplicationStatusSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -87,7 +112,8 @@ func resourceArmSharedImageVersion() *schema.Resource {
 
 func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	imageVersion := d.Get("name").(string)
 	imageName := d.Get("image_name").(string)
@@ -126,7 +152,7 @@ func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta inte
 				},
 			},
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, galleryName, imageName, imageVersion, version)
@@ -138,7 +164,16 @@ func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error waiting for the creation of Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", imageVersion, imageName, galleryName, resourceGroup, err)
 	}
 
-	// TODO: poll?
+	if d.Get("wait_for_replication").(bool) {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+
+		if err := waitForSharedImageVersionReplication(ctx, client, resourceGroup, galleryName, imageName, imageVersion, timeout); err != nil {
+			return err
+		}
+	}
 
 	read, err := client.Get(ctx, resourceGroup, galleryName, imageName, imageVersion, "")
 	if err != nil {
@@ -152,7 +187,8 @@ func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta inte
 
 func resourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -198,16 +234,23 @@ func resourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{})
 				}
 			}
 		}
+
+		replicationState, regionalReplicationStatus := flattenSharedImageVersionReplicationStatus(props.ReplicationStatus)
+		d.Set("replication_state", replicationState)
+		if err := d.Set("regional_replication_status", regionalReplicationStatus); err != nil {
+			return fmt.Errorf("Error setting `regional_replication_status`: %+v", err)
+		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmSharedImageVersionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).galleryImageVersionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -236,6 +279,143 @@ func resourceArmSharedImageVersionDelete(d *schema.ResourceData, meta interface{
 
 	return nil
 }
+
+func sharedImageVersionRegionalReplicationStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"region": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"progress": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+
+				"details": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// waitForSharedImageVersionReplication waits for the Shared Image Version to be replicated to all of the Target
+// Regions, returning an error detailing the regions where replication failed (if any)
+func waitForSharedImageVersionReplication(ctx context.Context, client compute.GalleryImageVersionsClient, resourceGroup, galleryName, imageName, imageVersion string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for Shared Image Version %q (Image %q / Gallery %q / Resource Group %q) to be replicated..", imageVersion, imageName, galleryName, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(compute.InProgress), string(compute.Unknown)},
+		Target:     []string{string(compute.Completed)},
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, resourceGroup, galleryName, imageName, imageVersion, compute.ReplicationStatusTypesReplicationStatus)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error retrieving the Replication Status of Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", imageVersion, imageName, galleryName, resourceGroup, err)
+			}
+
+			if resp.GalleryImageVersionProperties == nil || resp.GalleryImageVersionProperties.ReplicationStatus == nil {
+				return resp, string(compute.Unknown), nil
+			}
+
+			status := resp.GalleryImageVersionProperties.ReplicationStatus
+			if status.AggregatedState == compute.Failed {
+				return nil, "", fmt.Errorf("Error replicating Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %s", imageVersion, imageName, galleryName, resourceGroup, sharedImageVersionReplicationFailures(status.Summary))
+			}
+
+			state := string(status.AggregatedState)
+			if state == "" {
+				state = string(compute.Unknown)
+			}
+
+			return resp, state, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Shared Image Version %q (Image %q / Gallery %q / Resource Group %q) to be replicated: %+v", imageVersion, imageName, galleryName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// sharedImageVersionReplicationFailures returns a description of the regions where replication failed
+func sharedImageVersionReplicationFailures(input *[]compute.RegionalReplicationStatus) string {
+	failures := make([]string, 0)
+
+	if input != nil {
+		for _, v := range *input {
+			if v.State != compute.ReplicationStateFailed {
+				continue
+			}
+
+			region := ""
+			if v.Region != nil {
+				region = azureRMNormalizeLocation(*v.Region)
+			}
+
+			details := "no details were returned"
+			if v.Details != nil && *v.Details != "" {
+				details = *v.Details
+			}
+
+			failures = append(failures, fmt.Sprintf("%q (%s)", region, details))
+		}
+	}
+
+	if len(failures) == 0 {
+		return "replication failed"
+	}
+
+	return fmt.Sprintf("replication failed in the region(s) %s", strings.Join(failures, ", "))
+}
+
+func flattenSharedImageVersionReplicationStatus(input *compute.ReplicationStatus) (string, []interface{}) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return "", results
+	}
+
+	if input.Summary != nil {
+		for _, v := range *input.Summary {
+			region := ""
+			if v.Region != nil {
+				region = azureRMNormalizeLocation(*v.Region)
+			}
+
+			progress := 0
+			if v.Progress != nil {
+				progress = int(*v.Progress)
+			}
+
+			details := ""
+			if v.Details != nil {
+				details = *v.Details
+			}
+
+			results = append(results, map[string]interface{}{
+				"region":   region,
+				"state":    string(v.State),
+				"progress": progress,
+				"details":  details,
+			})
+		}
+	}
+
+	return string(input.AggregatedState), results
+}
+
 func expandSharedImageVersionTargetRegions(d *schema.ResourceData) *[]compute.TargetRegion {
 	vs := d.Get("target_region").(*schema.Set)
 	results := make([]compute.TargetRegion, 0)
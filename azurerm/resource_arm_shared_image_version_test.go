@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -56,6 +57,10 @@ func TestAccAzureRMSharedImageVersion_basic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"wait_for_replication",
+				},
 			},
 		},
 	})
@@ -104,6 +109,107 @@ func TestAccAzureRMSharedImageVersion_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSharedImageVersion_waitForReplication(t *testing.T) {
+	resourceName := "azurerm_shared_image_version.test"
+
+	ri := tf.AccRandTimeInt()
+	resourceGroup := fmt.Sprintf("acctestRG-%d", ri)
+	userName := "testadmin"
+	password := "Password1234!"
+	hostName := fmt.Sprintf("tftestcustomimagesrc%d", ri)
+	sshPort := "22"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSharedImageVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				// need to create a vm and then reference it in the image creation
+				Config:  testAccAzureRMSharedImageVersion_setup(ri, testLocation(), userName, password, hostName),
+				Destroy: false,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureVMExists("azurerm_virtual_machine.testsource", true),
+					testGeneralizeVMImage(resourceGroup, "testsource", userName, password, hostName, sshPort, testLocation()),
+				),
+			},
+			{
+				Config: testAccAzureRMSharedImageVersion_waitForReplication(ri, testLocation(), testAltLocation(), userName, password, hostName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_replication", "true"),
+					resource.TestCheckResourceAttr(resourceName, "replication_state", string(compute.Completed)),
+					resource.TestCheckResourceAttr(resourceName, "regional_replication_status.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "regional_replication_status.0.state", string(compute.ReplicationStateCompleted)),
+					resource.TestCheckResourceAttr(resourceName, "regional_replication_status.1.state", string(compute.ReplicationStateCompleted)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"wait_for_replication",
+				},
+			},
+		},
+	})
+}
+
+func TestSharedImageVersionReplicationFailures(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *[]compute.RegionalReplicationStatus
+		Expected string
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: "replication failed",
+		},
+		{
+			Name: "Single Failure",
+			Input: &[]compute.RegionalReplicationStatus{
+				{
+					Region: utils.String("West Europe"),
+					State:  compute.ReplicationStateCompleted,
+				},
+				{
+					Region:  utils.String("North Europe"),
+					State:   compute.ReplicationStateFailed,
+					Details: utils.String("Quota exceeded"),
+				},
+			},
+			Expected: `replication failed in the region(s) "northeurope" (Quota exceeded)`,
+		},
+		{
+			Name: "Multiple Failures",
+			Input: &[]compute.RegionalReplicationStatus{
+				{
+					Region:  utils.String("westeurope"),
+					State:   compute.ReplicationStateFailed,
+					Details: utils.String("Quota exceeded"),
+				},
+				{
+					Region: utils.String("northeurope"),
+					State:  compute.ReplicationStateFailed,
+				},
+			},
+			Expected: `replication failed in the region(s) "westeurope" (Quota exceeded), "northeurope" (no details were returned)`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual := sharedImageVersionReplicationFailures(tc.Input)
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
 func testCheckAzureRMSharedImageVersionDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).galleryImageVersionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -261,3 +367,30 @@ resource "azurerm_shared_image_version" "test" {
 }
 `, template, altLocation)
 }
+
+func testAccAzureRMSharedImageVersion_waitForReplication(rInt int, location, altLocation, username, password, hostname string) string {
+	template := testAccAzureRMSharedImageVersion_provision(rInt, location, username, password, hostname)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "test" {
+  name                 = "0.0.1"
+  gallery_name         = "${azurerm_shared_image_gallery.test.name}"
+  image_name           = "${azurerm_shared_image.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  location             = "${azurerm_resource_group.test.location}"
+  managed_image_id     = "${azurerm_image.test.id}"
+  wait_for_replication = true
+
+  target_region {
+    name                   = "${azurerm_resource_group.test.location}"
+    regional_replica_count = 1
+  }
+
+  target_region {
+    name                   = "%s"
+    regional_replica_count = 1
+  }
+}
+`, template, altLocation)
+}
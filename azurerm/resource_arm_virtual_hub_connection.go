@@ -0,0 +1,256 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Virtual Hub Connections can't be created or deleted individually in this version of the Network API - instead they're
+// managed as a part of the Virtual Hub, which is updated (whilst locked) to add or remove the Connection
+func resourceArmVirtualHubConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubConnectionCreateUpdate,
+		Read:   resourceArmVirtualHubConnectionRead,
+		Update: resourceArmVirtualHubConnectionCreateUpdate,
+		Delete: resourceArmVirtualHubConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateVirtualHubId,
+			},
+
+			"remote_virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateVirtualNetworkId,
+			},
+
+			"allow_hub_to_remote_vnet_transit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allow_remote_vnet_to_use_hub_vnet_gateways": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_internet_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualHubConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	hubId, err := resourceid.ParseVirtualHubId(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(hubId.Name, virtualHubResourceName)
+	defer azureRMUnlockByName(hubId.Name, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, hubId.ResourceGroup, hubId.Name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubId.Name, hubId.ResourceGroup, err)
+	}
+
+	if hub.VirtualHubProperties == nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): `properties` was nil", hubId.Name, hubId.ResourceGroup)
+	}
+
+	id := resourceid.NewVirtualHubConnectionId(hubId.SubscriptionId, hubId.ResourceGroup, hubId.Name, name)
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		if findVirtualHubConnection(hub.VirtualHubProperties.VirtualNetworkConnections, name) != nil {
+			return tf.ImportAsExistsError("azurerm_virtual_hub_connection", id.String())
+		}
+	}
+
+	connections := removeVirtualHubConnection(hub.VirtualHubProperties.VirtualNetworkConnections, name)
+	connections = append(connections, network.HubVirtualNetworkConnection{
+		Name: utils.String(name),
+		HubVirtualNetworkConnectionProperties: &network.HubVirtualNetworkConnectionProperties{
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: utils.String(d.Get("remote_virtual_network_id").(string)),
+			},
+			AllowHubToRemoteVnetTransit:         utils.Bool(d.Get("allow_hub_to_remote_vnet_transit").(bool)),
+			AllowRemoteVnetToUseHubVnetGateways: utils.Bool(d.Get("allow_remote_vnet_to_use_hub_vnet_gateways").(bool)),
+			EnableInternetSecurity:              utils.Bool(d.Get("enable_internet_security").(bool)),
+		},
+	})
+	hub.VirtualHubProperties.VirtualNetworkConnections = &connections
+
+	log.Printf("[DEBUG] Creating/Updating Connection %q (Virtual Hub %q / Resource Group %q)..", name, hubId.Name, hubId.ResourceGroup)
+	future, err := client.CreateOrUpdate(ctx, hubId.ResourceGroup, hubId.Name, hub)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q (Virtual Hub %q / Resource Group %q): %+v", name, hubId.Name, hubId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q (Virtual Hub %q / Resource Group %q): %+v", name, hubId.Name, hubId.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Created/Updated Connection %q (Virtual Hub %q / Resource Group %q).", name, hubId.Name, hubId.ResourceGroup)
+
+	d.SetId(id.String())
+
+	return resourceArmVirtualHubConnectionRead(d, meta)
+}
+
+func resourceArmVirtualHubConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubConnectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualHubConnectionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q (Virtual Hub %q / Resource Group %q) was not found - removing from state!", id.Name, id.VirtualHubName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Connection %q (Virtual Hub %q / Resource Group %q): %+v", id.Name, id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	hubId := resourceid.NewVirtualHubId(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName)
+
+	d.Set("name", id.Name)
+	d.Set("virtual_hub_id", hubId.String())
+
+	if props := resp.HubVirtualNetworkConnectionProperties; props != nil {
+		remoteVirtualNetworkId := ""
+		if props.RemoteVirtualNetwork != nil && props.RemoteVirtualNetwork.ID != nil {
+			remoteVirtualNetworkId = *props.RemoteVirtualNetwork.ID
+		}
+		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
+		d.Set("allow_hub_to_remote_vnet_transit", props.AllowHubToRemoteVnetTransit)
+		d.Set("allow_remote_vnet_to_use_hub_vnet_gateways", props.AllowRemoteVnetToUseHubVnetGateways)
+		d.Set("enable_internet_security", props.EnableInternetSecurity)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualHubConnectionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.VirtualHubName, virtualHubResourceName)
+	defer azureRMUnlockByName(id.VirtualHubName, virtualHubResourceName)
+
+	hub, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			// the Connection is removed along with the Virtual Hub
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	if hub.VirtualHubProperties == nil || findVirtualHubConnection(hub.VirtualHubProperties.VirtualNetworkConnections, id.Name) == nil {
+		return nil
+	}
+
+	connections := removeVirtualHubConnection(hub.VirtualHubProperties.VirtualNetworkConnections, id.Name)
+	hub.VirtualHubProperties.VirtualNetworkConnections = &connections
+
+	log.Printf("[DEBUG] Deleting Connection %q (Virtual Hub %q / Resource Group %q)..", id.Name, id.VirtualHubName, id.ResourceGroup)
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, hub)
+	if err != nil {
+		return fmt.Errorf("Error deleting Connection %q (Virtual Hub %q / Resource Group %q): %+v", id.Name, id.VirtualHubName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Connection %q (Virtual Hub %q / Resource Group %q): %+v", id.Name, id.VirtualHubName, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Deleted Connection %q (Virtual Hub %q / Resource Group %q).", id.Name, id.VirtualHubName, id.ResourceGroup)
+
+	return nil
+}
+
+func findVirtualHubConnection(input *[]network.HubVirtualNetworkConnection, name string) *network.HubVirtualNetworkConnection {
+	if input == nil {
+		return nil
+	}
+
+	for _, v := range *input {
+		if v.Name != nil && strings.EqualFold(*v.Name, name) {
+			connection := v
+			return &connection
+		}
+	}
+
+	return nil
+}
+
+// removeVirtualHubConnection returns the Virtual Hub Connections excluding the Connection with the specified name
+func removeVirtualHubConnection(input *[]network.HubVirtualNetworkConnection, name string) []network.HubVirtualNetworkConnection {
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	if input == nil {
+		return connections
+	}
+
+	for _, v := range *input {
+		if v.Name != nil && strings.EqualFold(*v.Name, name) {
+			continue
+		}
+
+		connections = append(connections, v)
+	}
+
+	return connections
+}
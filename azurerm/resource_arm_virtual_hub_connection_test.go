@@ -0,0 +1,260 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestRemoveVirtualHubConnection(t *testing.T) {
+	input := &[]network.HubVirtualNetworkConnection{
+		{Name: utils.String("first")},
+		{Name: utils.String("Second")},
+		{Name: utils.String("third")},
+	}
+
+	cases := []struct {
+		Name     string
+		Input    *[]network.HubVirtualNetworkConnection
+		Expected []string
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: []string{},
+		},
+		{
+			Name:     "second",
+			Input:    input,
+			Expected: []string{"first", "third"},
+		},
+		{
+			Name:     "fourth",
+			Input:    input,
+			Expected: []string{"first", "Second", "third"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing removing %q", tc.Name)
+
+		actual := removeVirtualHubConnection(tc.Input, tc.Name)
+		if len(actual) != len(tc.Expected) {
+			t.Fatalf("Expected %d Connections but got %d", len(tc.Expected), len(actual))
+		}
+
+		for i, name := range tc.Expected {
+			if *actual[i].Name != name {
+				t.Fatalf("Expected Connection %d to be %q but got %q", i, name, *actual[i].Name)
+			}
+		}
+
+		if findVirtualHubConnection(&actual, tc.Name) != nil {
+			t.Fatalf("Expected Connection %q to have been removed", tc.Name)
+		}
+	}
+}
+
+func TestAccAzureRMVirtualHubConnection_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualHubConnection_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_hub_connection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_update(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_internet_security", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualHubConnection_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_internet_security", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualHubConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseVirtualHubConnectionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).virtualHubConnectionClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q (Virtual Hub %q / Resource Group %q) does not exist", id.Name, id.VirtualHubName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on virtualHubConnectionClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualHubConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).virtualHubConnectionClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_hub_connection" {
+			continue
+		}
+
+		id, err := resourceid.ParseVirtualHubConnectionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Hub Connection still exists:\n%#v", resp.HubVirtualNetworkConnectionProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualHubConnection_template(rInt int, location string) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["172.16.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctestvhubconn-%d"
+  virtual_hub_id            = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id = "${azurerm_virtual_network.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "import" {
+  name                      = "${azurerm_virtual_hub_connection.test.name}"
+  virtual_hub_id            = "${azurerm_virtual_hub_connection.test.virtual_hub_id}"
+  remote_virtual_network_id = "${azurerm_virtual_hub_connection.test.remote_virtual_network_id}"
+}
+`, template)
+}
+
+func testAccAzureRMVirtualHubConnection_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctestvhubconn-%d"
+  virtual_hub_id            = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id = "${azurerm_virtual_network.test.id}"
+  enable_internet_security  = true
+}
+`, template, rInt)
+}
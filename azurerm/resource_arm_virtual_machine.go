@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"golang.org/x/net/context"
 )
@@ -30,6 +32,15 @@ func resourceArmVirtualMachine() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: validateResourceSkuAvailabilityDiff("virtualMachines", "vm_size"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -43,29 +54,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 
 			"zones": singleZonesSchema(),
 
-			"plan": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-
-						"publisher": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-
-						"product": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"plan": virtualMachinePlanSchema(),
 
 			"availability_set_id": {
 				Type:     schema.TypeString,
@@ -78,37 +67,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 				ConflictsWith: []string{"zones"},
 			},
 
-			"identity": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(compute.ResourceIdentityTypeSystemAssigned),
-								string(compute.ResourceIdentityTypeUserAssigned),
-								string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
-							}, false),
-						},
-						"principal_id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"identity_ids": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-						},
-					},
-				},
-			},
+			"identity": virtualMachineIdentitySchema(),
 
 			"license_type": {
 				Type:             schema.TypeString,
@@ -334,24 +293,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Default:  false,
 			},
 
-			"boot_diagnostics": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"enabled": {
-							Type:     schema.TypeBool,
-							Required: true,
-						},
-
-						"storage_uri": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
 
 			"os_profile": {
 				Type:     schema.TypeSet,
@@ -506,35 +448,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 				ConflictsWith: []string{"os_profile_windows_config"},
 			},
 
-			"os_profile_secrets": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"source_vault_id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-
-						"vault_certificates": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"certificate_url": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"certificate_store": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"os_profile_secrets": virtualMachineSecretSchema(),
 
 			"network_interface_ids": {
 				Type:     schema.TypeList,
@@ -556,7 +470,8 @@ func resourceArmVirtualMachine() *schema.Resource {
 
 func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine creation.")
 
@@ -578,7 +493,7 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(tags, meta)
 	zones := expandZones(d.Get("zones").([]interface{}))
 
 	osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
@@ -711,7 +626,8 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	vmClient := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -760,7 +676,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			}
 
 			if osDisk := profile.OsDisk; osDisk != nil {
-				diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(osDisk.ManagedDisk, meta)
+				diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, osDisk.ManagedDisk, meta)
 				if err != nil {
 					return fmt.Errorf("Error flattening `storage_os_disk`: %#v", err)
 				}
@@ -772,7 +688,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 			if dataDisks := profile.DataDisks; dataDisks != nil {
 				disksInfo := make([]*compute.Disk, len(*dataDisks))
 				for i, dataDisk := range *dataDisks {
-					diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(dataDisk.ManagedDisk, meta)
+					diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, dataDisk.ManagedDisk, meta)
 					if err != nil {
 						return fmt.Errorf("[DEBUG] Error getting managed data disk detailed information: %#v", err)
 					}
@@ -826,14 +742,15 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -865,13 +782,13 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 
 		if osDisk.Vhd != nil {
 			if osDisk.Vhd.URI != nil {
-				if err = resourceArmVirtualMachineDeleteVhd(*osDisk.Vhd.URI, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteVhd(ctx, *osDisk.Vhd.URI, meta); err != nil {
 					return fmt.Errorf("Error deleting OS Disk VHD: %+v", err)
 				}
 			}
 		} else if osDisk.ManagedDisk != nil {
 			if osDisk.ManagedDisk.ID != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(*osDisk.ManagedDisk.ID, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *osDisk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting OS Managed Disk: %+v", err)
 				}
 			}
@@ -891,11 +808,11 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 
 		for _, disk := range disks {
 			if disk.Vhd != nil {
-				if err = resourceArmVirtualMachineDeleteVhd(*disk.Vhd.URI, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteVhd(ctx, *disk.Vhd.URI, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Disk VHD: %+v", err)
 				}
 			} else if disk.ManagedDisk != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(*disk.ManagedDisk.ID, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *disk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Managed Disk: %+v", err)
 				}
 			} else {
@@ -907,9 +824,8 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
+func resourceArmVirtualMachineDeleteVhd(ctx context.Context, uri string, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
 	environment := armClient.environment
 
 	vhdURL, err := url.Parse(uri)
@@ -928,7 +844,7 @@ func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	containerName := path[0]
 	blobName := path[1]
 
-	resourceGroupName, err := findStorageAccountResourceGroup(meta, storageAccountName)
+	resourceGroupName, err := findStorageAccountResourceGroup(ctx, meta, storageAccountName)
 	if err != nil {
 		return fmt.Errorf("Error finding resource group for storage account %s: %+v", storageAccountName, err)
 	}
@@ -955,9 +871,8 @@ func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta interface{}) error {
+func resourceArmVirtualMachineDeleteManagedDisk(ctx context.Context, managedDiskID string, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
 
 	id, err := parseAzureResourceID(managedDiskID)
 	if err != nil {
@@ -1173,38 +1088,8 @@ func flattenAzureRmVirtualMachineOsProfileWindowsConfiguration(config *compute.W
 		result["timezone"] = *config.TimeZone
 	}
 
-	listeners := make([]map[string]interface{}, 0)
-	if config.WinRM != nil && config.WinRM.Listeners != nil {
-		for _, i := range *config.WinRM.Listeners {
-			listener := make(map[string]interface{})
-			listener["protocol"] = string(i.Protocol)
-
-			if i.CertificateURL != nil {
-				listener["certificate_url"] = *i.CertificateURL
-			}
-
-			listeners = append(listeners, listener)
-		}
-	}
-
-	result["winrm"] = listeners
-
-	content := make([]map[string]interface{}, 0)
-	if config.AdditionalUnattendContent != nil {
-		for _, i := range *config.AdditionalUnattendContent {
-			c := make(map[string]interface{})
-			c["pass"] = string(i.PassName)
-			c["component"] = string(i.ComponentName)
-			c["setting_name"] = string(i.SettingName)
-
-			if i.Content != nil {
-				c["content"] = *i.Content
-			}
-
-			content = append(content, c)
-		}
-	}
-	result["additional_unattend_config"] = content
+	result["winrm"] = flattenVirtualMachineWinRMListeners(config.WinRM)
+	result["additional_unattend_config"] = flattenVirtualMachineAdditionalUnattendContent(config.AdditionalUnattendContent)
 
 	return []interface{}{result}
 }
@@ -1287,41 +1172,11 @@ func flattenAzureRmVirtualMachineReviseDiskInfo(result map[string]interface{}, d
 }
 
 func expandAzureRmVirtualMachinePlan(d *schema.ResourceData) (*compute.Plan, error) {
-	planConfigs := d.Get("plan").([]interface{})
-
-	planConfig := planConfigs[0].(map[string]interface{})
-
-	publisher := planConfig["publisher"].(string)
-	name := planConfig["name"].(string)
-	product := planConfig["product"].(string)
-
-	return &compute.Plan{
-		Publisher: &publisher,
-		Name:      &name,
-		Product:   &product,
-	}, nil
+	return expandVirtualMachinePlan(d.Get("plan").([]interface{})), nil
 }
 
 func expandAzureRmVirtualMachineIdentity(d *schema.ResourceData) *compute.VirtualMachineIdentity {
-	v := d.Get("identity")
-	identities := v.([]interface{})
-	identity := identities[0].(map[string]interface{})
-	identityType := compute.ResourceIdentityType(identity["type"].(string))
-
-	identityIds := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
-	for _, id := range identity["identity_ids"].([]interface{}) {
-		identityIds[id.(string)] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
-	}
-
-	vmIdentity := compute.VirtualMachineIdentity{
-		Type: identityType,
-	}
-
-	if vmIdentity.Type == compute.ResourceIdentityTypeUserAssigned || vmIdentity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned {
-		vmIdentity.UserAssignedIdentities = identityIds
-	}
-
-	return &vmIdentity
+	return expandVirtualMachineIdentity(d.Get("identity").([]interface{}))
 }
 
 func expandAzureRmVirtualMachineOsProfile(d *schema.ResourceData) (*compute.OSProfile, error) {
@@ -1382,42 +1237,7 @@ func expandAzureRmVirtualMachineOsProfile(d *schema.ResourceData) (*compute.OSPr
 }
 
 func expandAzureRmVirtualMachineOsProfileSecrets(d *schema.ResourceData) *[]compute.VaultSecretGroup {
-	secretsConfig := d.Get("os_profile_secrets").([]interface{})
-	secrets := make([]compute.VaultSecretGroup, 0, len(secretsConfig))
-
-	for _, secretConfig := range secretsConfig {
-		config := secretConfig.(map[string]interface{})
-		sourceVaultId := config["source_vault_id"].(string)
-
-		vaultSecretGroup := compute.VaultSecretGroup{
-			SourceVault: &compute.SubResource{
-				ID: &sourceVaultId,
-			},
-		}
-
-		if v := config["vault_certificates"]; v != nil {
-			certsConfig := v.([]interface{})
-			certs := make([]compute.VaultCertificate, 0, len(certsConfig))
-			for _, certConfig := range certsConfig {
-				config := certConfig.(map[string]interface{})
-
-				certUrl := config["certificate_url"].(string)
-				cert := compute.VaultCertificate{
-					CertificateURL: &certUrl,
-				}
-				if v := config["certificate_store"].(string); v != "" {
-					cert.CertificateStore = &v
-				}
-
-				certs = append(certs, cert)
-			}
-			vaultSecretGroup.VaultCertificates = &certs
-		}
-
-		secrets = append(secrets, vaultSecretGroup)
-	}
-
-	return &secrets
+	return expandVirtualMachineSecrets(d.Get("os_profile_secrets").([]interface{}))
 }
 
 func expandAzureRmVirtualMachineOsProfileLinuxConfig(d *schema.ResourceData) (*compute.LinuxConfiguration, error) {
@@ -1479,51 +1299,13 @@ func expandAzureRmVirtualMachineOsProfileWindowsConfig(d *schema.ResourceData) (
 	}
 
 	if v := osProfileConfig["winrm"]; v != nil {
-		winRm := v.([]interface{})
-		if len(winRm) > 0 {
-			winRmListeners := make([]compute.WinRMListener, 0, len(winRm))
-			for _, winRmConfig := range winRm {
-				config := winRmConfig.(map[string]interface{})
-
-				protocol := config["protocol"].(string)
-				winRmListener := compute.WinRMListener{
-					Protocol: compute.ProtocolTypes(protocol),
-				}
-				if v := config["certificate_url"].(string); v != "" {
-					winRmListener.CertificateURL = &v
-				}
-
-				winRmListeners = append(winRmListeners, winRmListener)
-			}
-			config.WinRM = &compute.WinRMConfiguration{
-				Listeners: &winRmListeners,
-			}
+		if winRm := v.([]interface{}); len(winRm) > 0 {
+			config.WinRM = expandVirtualMachineWinRMListeners(winRm)
 		}
 	}
 	if v := osProfileConfig["additional_unattend_config"]; v != nil {
-		additionalConfig := v.([]interface{})
-		if len(additionalConfig) > 0 {
-			additionalConfigContent := make([]compute.AdditionalUnattendContent, 0, len(additionalConfig))
-			for _, addConfig := range additionalConfig {
-				config := addConfig.(map[string]interface{})
-				pass := config["pass"].(string)
-				component := config["component"].(string)
-				settingName := config["setting_name"].(string)
-				content := config["content"].(string)
-
-				addContent := compute.AdditionalUnattendContent{
-					PassName:      compute.PassNames(pass),
-					ComponentName: compute.ComponentNames(component),
-					SettingName:   compute.SettingNames(settingName),
-				}
-
-				if content != "" {
-					addContent.Content = &content
-				}
-
-				additionalConfigContent = append(additionalConfigContent, addContent)
-			}
-			config.AdditionalUnattendContent = &additionalConfigContent
+		if additionalConfig := v.([]interface{}); len(additionalConfig) > 0 {
+			config.AdditionalUnattendContent = expandVirtualMachineAdditionalUnattendContent(additionalConfig)
 		}
 	}
 	return config, nil
@@ -1595,23 +1377,7 @@ func expandAzureRmVirtualMachineDataDisk(d *schema.ResourceData) ([]compute.Data
 }
 
 func expandAzureRmVirtualMachineDiagnosticsProfile(d *schema.ResourceData) *compute.DiagnosticsProfile {
-	bootDiagnostics := d.Get("boot_diagnostics").([]interface{})
-
-	diagnosticsProfile := &compute.DiagnosticsProfile{}
-	if len(bootDiagnostics) > 0 {
-		bootDiagnostic := bootDiagnostics[0].(map[string]interface{})
-
-		diagnostic := &compute.BootDiagnostics{
-			Enabled:    utils.Bool(bootDiagnostic["enabled"].(bool)),
-			StorageURI: utils.String(bootDiagnostic["storage_uri"].(string)),
-		}
-
-		diagnosticsProfile.BootDiagnostics = diagnostic
-
-		return diagnosticsProfile
-	}
-
-	return nil
+	return expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{}))
 }
 
 func expandAzureRmVirtualMachineImageReference(d *schema.ResourceData) (*compute.ImageReference, error) {
@@ -1648,26 +1414,10 @@ func expandAzureRmVirtualMachineImageReference(d *schema.ResourceData) (*compute
 func expandAzureRmVirtualMachineNetworkProfile(d *schema.ResourceData) compute.NetworkProfile {
 	nicIds := d.Get("network_interface_ids").([]interface{})
 	primaryNicId := d.Get("primary_network_interface_id").(string)
-	network_interfaces := make([]compute.NetworkInterfaceReference, 0, len(nicIds))
-
-	network_profile := compute.NetworkProfile{}
-
-	for _, nic := range nicIds {
-		id := nic.(string)
-		primary := id == primaryNicId
 
-		network_interface := compute.NetworkInterfaceReference{
-			ID: &id,
-			NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{
-				Primary: &primary,
-			},
-		}
-		network_interfaces = append(network_interfaces, network_interface)
+	return compute.NetworkProfile{
+		NetworkInterfaces: expandVirtualMachineNetworkInterfaceIDs(nicIds, primaryNicId),
 	}
-
-	network_profile.NetworkInterfaces = &network_interfaces
-
-	return network_profile
 }
 
 func expandAzureRmVirtualMachineOsDisk(d *schema.ResourceData) (*compute.OSDisk, error) {
@@ -1742,9 +1492,8 @@ func expandAzureRmVirtualMachineOsDisk(d *schema.ResourceData) (*compute.OSDisk,
 	return osDisk, nil
 }
 
-func findStorageAccountResourceGroup(meta interface{}, storageAccountName string) (string, error) {
+func findStorageAccountResourceGroup(ctx context.Context, meta interface{}, storageAccountName string) (string, error) {
 	client := meta.(*ArmClient).resourcesClient
-	ctx := meta.(*ArmClient).StopContext
 	filter := fmt.Sprintf("name eq '%s' and resourceType eq 'Microsoft.Storage/storageAccounts'", storageAccountName)
 	expand := ""
 	var pager *int32
@@ -1827,9 +1576,8 @@ func resourceArmVirtualMachineStorageImageReferenceHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func resourceArmVirtualMachineGetManagedDiskInfo(disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
+func resourceArmVirtualMachineGetManagedDiskInfo(ctx context.Context, disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
 
 	if disk == nil || disk.ID == nil {
 		return nil, nil
@@ -0,0 +1,233 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Run Commands are actions rather than resources in the Compute API - as such the Command is run when this
+// resource is created, with any change (including to the `triggers`) running the Command again
+func resourceArmVirtualMachineRunCommand() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineRunCommandCreate,
+		Read:   resourceArmVirtualMachineRunCommandRead,
+		Delete: resourceArmVirtualMachineRunCommandDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_machine_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateVirtualMachineId,
+			},
+
+			"command_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"script": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"stdout": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"stderr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineRunCommandCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	virtualMachineId, err := resourceid.ParseVirtualMachineId(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	input := compute.RunCommandInput{
+		CommandID:  utils.String(d.Get("command_id").(string)),
+		Parameters: expandVirtualMachineRunCommandParameters(d.Get("parameters").(map[string]interface{})),
+	}
+
+	if v := d.Get("script").(string); v != "" {
+		script := strings.Split(v, "\n")
+		input.Script = &script
+	}
+
+	// only a single Command can be run on a Virtual Machine at once
+	azureRMLockByName(virtualMachineId.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(virtualMachineId.Name, virtualMachineResourceName)
+
+	log.Printf("[DEBUG] Running Command %q on Virtual Machine %q (Resource Group %q)..", name, virtualMachineId.Name, virtualMachineId.ResourceGroup)
+	future, err := client.RunCommand(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, input)
+	if err != nil {
+		return fmt.Errorf("Error running Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Command %q to finish running on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the result of Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineId.Name, virtualMachineId.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Ran Command %q on Virtual Machine %q (Resource Group %q).", name, virtualMachineId.Name, virtualMachineId.ResourceGroup)
+
+	id := resourceid.NewVirtualMachineRunCommandId(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, name)
+	d.SetId(id.String())
+
+	stdout, stderr := flattenVirtualMachineRunCommandOutput(result.Value)
+	d.Set("stdout", stdout)
+	d.Set("stderr", stderr)
+
+	return resourceArmVirtualMachineRunCommandRead(d, meta)
+}
+
+func resourceArmVirtualMachineRunCommandRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineRunCommandId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the output of a Command can't be retrieved after it's run, so we only check the Virtual Machine still exists
+	virtualMachine, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			log.Printf("[DEBUG] Virtual Machine %q (Resource Group %q) was not found - removing Command %q from state!", id.VirtualMachineName, id.ResourceGroup, id.Name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", id.VirtualMachineName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("virtual_machine_id", resourceid.NewVirtualMachineId(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName).String())
+
+	return nil
+}
+
+func resourceArmVirtualMachineRunCommandDelete(d *schema.ResourceData, meta interface{}) error {
+	// there's nothing to delete, since the Command has already been run
+	return nil
+}
+
+func expandVirtualMachineRunCommandParameters(input map[string]interface{}) *[]compute.RunCommandInputParameter {
+	parameters := make([]compute.RunCommandInputParameter, 0)
+
+	// sort the parameters so they're passed to the Command in a consistent order
+	names := make([]string, 0)
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		parameters = append(parameters, compute.RunCommandInputParameter{
+			Name:  utils.String(name),
+			Value: utils.String(input[name].(string)),
+		})
+	}
+
+	return &parameters
+}
+
+// flattenVirtualMachineRunCommandOutput returns the stdout and stderr of a Command. Windows Virtual Machines return
+// these as Statuses with the codes `ComponentStatus/StdOut/{state}` and `ComponentStatus/StdErr/{state}` - whereas
+// Linux Virtual Machines return a single Status with a message in the format `{summary}\n[stdout]\n...\n[stderr]\n...`
+func flattenVirtualMachineRunCommandOutput(input *[]compute.InstanceViewStatus) (string, string) {
+	stdout := ""
+	stderr := ""
+	if input == nil {
+		return stdout, stderr
+	}
+
+	for _, status := range *input {
+		if status.Code == nil || status.Message == nil {
+			continue
+		}
+
+		segments := strings.Split(*status.Code, "/")
+		if len(segments) >= 2 && strings.EqualFold(segments[0], "ComponentStatus") {
+			switch strings.ToLower(segments[1]) {
+			case "stdout":
+				stdout = *status.Message
+			case "stderr":
+				stderr = *status.Message
+			}
+			continue
+		}
+
+		message := *status.Message
+		stdoutIndex := strings.Index(message, "[stdout]\n")
+		if stdoutIndex == -1 {
+			continue
+		}
+		message = message[stdoutIndex+len("[stdout]\n"):]
+
+		if stderrIndex := strings.Index(message, "[stderr]\n"); stderrIndex != -1 {
+			stderr = strings.TrimRight(message[stderrIndex+len("[stderr]\n"):], "\n")
+			message = message[:stderrIndex]
+		}
+		stdout = strings.TrimRight(message, "\n")
+	}
+
+	return stdout, stderr
+}
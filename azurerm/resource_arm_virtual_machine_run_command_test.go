@@ -0,0 +1,258 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenVirtualMachineRunCommandOutput(t *testing.T) {
+	cases := []struct {
+		Name           string
+		Input          *[]compute.InstanceViewStatus
+		ExpectedStdOut string
+		ExpectedStdErr string
+	}{
+		{
+			Name:  "Nil",
+			Input: nil,
+		},
+		{
+			Name: "Linux",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ProvisioningState/succeeded"),
+					Message: utils.String("Enable succeeded: \n[stdout]\nhello\nworld\n\n[stderr]\nwarning\n"),
+				},
+			},
+			ExpectedStdOut: "hello\nworld",
+			ExpectedStdErr: "warning",
+		},
+		{
+			Name: "Linux No Output",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ProvisioningState/succeeded"),
+					Message: utils.String("Enable succeeded: \n[stdout]\n\n[stderr]\n"),
+				},
+			},
+		},
+		{
+			Name: "Windows",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ComponentStatus/StdOut/succeeded"),
+					Message: utils.String("hello"),
+				},
+				{
+					Code:    utils.String("ComponentStatus/StdErr/succeeded"),
+					Message: utils.String("warning"),
+				},
+			},
+			ExpectedStdOut: "hello",
+			ExpectedStdErr: "warning",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		stdout, stderr := flattenVirtualMachineRunCommandOutput(tc.Input)
+		if stdout != tc.ExpectedStdOut {
+			t.Fatalf("Expected stdout to be %q but got %q", tc.ExpectedStdOut, stdout)
+		}
+
+		if stderr != tc.ExpectedStdErr {
+			t.Fatalf("Expected stderr to be %q but got %q", tc.ExpectedStdErr, stderr)
+		}
+	}
+}
+
+func TestExpandVirtualMachineRunCommandParameters(t *testing.T) {
+	input := map[string]interface{}{
+		"second": "2",
+		"first":  "1",
+	}
+
+	actual := *expandVirtualMachineRunCommandParameters(input)
+	if len(actual) != 2 {
+		t.Fatalf("Expected 2 parameters but got %d", len(actual))
+	}
+
+	if *actual[0].Name != "first" || *actual[0].Value != "1" {
+		t.Fatalf("Expected the first parameter to be `first` but got %q", *actual[0].Name)
+	}
+
+	if *actual[1].Name != "second" || *actual[1].Value != "2" {
+		t.Fatalf("Expected the second parameter to be `second` but got %q", *actual[1].Name)
+	}
+}
+
+func TestAccAzureRMVirtualMachineRunCommand_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineRunCommandExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "stdout", "hello world"),
+					resource.TestCheckResourceAttr(resourceName, "stderr", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineRunCommand_parameters(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_parameters(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineRunCommandExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "stdout", regexp.MustCompile("acctest")),
+					resource.TestCheckResourceAttr(resourceName, "stderr", "oops"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineRunCommand_triggers(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLinuxVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_triggers(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineRunCommandExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stdout", "first"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_triggers(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineRunCommandExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stdout", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineRunCommandExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		// the Command itself isn't retrievable, so we check the Virtual Machine it was run on exists
+		virtualMachineId := rs.Primary.Attributes["virtual_machine_id"]
+		if virtualMachineId == "" {
+			return fmt.Errorf("Bad: `virtual_machine_id` was empty for %q", resourceName)
+		}
+
+		id, err := parseAzureResourceID(virtualMachineId)
+		if err != nil {
+			return err
+		}
+		name := id.Path["virtualMachines"]
+
+		client := testAccProvider.Meta().(*ArmClient).vmClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Machine %q (Resource Group %q) does not exist", name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vmClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMVirtualMachineRunCommand_basic(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestrc-%d"
+  virtual_machine_id = "${azurerm_linux_virtual_machine.test.id}"
+  command_id         = "RunShellScript"
+  script             = "echo 'hello world'"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineRunCommand_parameters(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachine_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestrc-%d"
+  virtual_machine_id = "${azurerm_linux_virtual_machine.test.id}"
+  command_id         = "RunShellScript"
+
+  script = <<SCRIPT
+echo "$1 $environment"
+echo 'oops' >&2
+SCRIPT
+
+  parameters = {
+    environment = "acctest"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineRunCommand_triggers(rInt int, location string, trigger string) string {
+	template := testAccAzureRMLinuxVirtualMachine_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestrc-%d"
+  virtual_machine_id = "${azurerm_linux_virtual_machine.test.id}"
+  command_id         = "RunShellScript"
+  script             = "echo '%s'"
+
+  triggers = {
+    run = "%s"
+  }
+}
+`, template, rInt, trigger, trigger)
+}
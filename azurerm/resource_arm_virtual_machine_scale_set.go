@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 
@@ -12,9 +13,10 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -27,7 +29,14 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 		Delete: resourceArmVirtualMachineScaleSetDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceArmVirtualMachineScaleSetImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -129,7 +138,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			"health_probe_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: resourceid.ValidateLoadBalancerProbeId,
 			},
 
 			"automatic_os_upgrade": {
@@ -138,43 +147,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Default:  false,
 			},
 
-			"rolling_upgrade_policy": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"max_batch_instance_percent": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      20,
-							ValidateFunc: validation.IntBetween(5, 100),
-						},
-
-						"max_unhealthy_instance_percent": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      20,
-							ValidateFunc: validation.IntBetween(5, 100),
-						},
-
-						"max_unhealthy_upgraded_instance_percent": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      20,
-							ValidateFunc: validation.IntBetween(5, 100),
-						},
-
-						"pause_time_between_batches": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Default:      "PT0S",
-							ValidateFunc: validateIso8601Duration(),
-						},
-					},
-				},
-				DiffSuppressFunc: azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff,
-			},
+			"rolling_upgrade_policy": virtualMachineScaleSetRollingUpgradePolicySchema("upgrade_policy_mode"),
 
 			"overprovision": {
 				Type:     schema.TypeBool,
@@ -252,7 +225,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						"source_vault_id": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: resourceid.ValidateKeyVaultId,
 						},
 
 						"vault_certificates": {
@@ -399,7 +372,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						"network_security_group_id": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: resourceid.ValidateNetworkSecurityGroupId,
 						},
 
 						"dns_settings": {
@@ -434,7 +407,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 									"subnet_id": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										ValidateFunc: resourceid.ValidateSubnetId,
 									},
 
 									"application_gateway_backend_address_pool_ids": {
@@ -449,7 +422,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 										Optional: true,
 										Elem: &schema.Schema{
 											Type:         schema.TypeString,
-											ValidateFunc: azure.ValidateResourceID,
+											ValidateFunc: resourceid.ValidateApplicationSecurityGroupId,
 										},
 										Set:      schema.HashString,
 										MaxItems: 20,
@@ -684,9 +657,12 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				},
 			},
 
+			// Extensions which aren't defined in this block (e.g. those managed by the
+			// `azurerm_virtual_machine_scale_set_extension` resource) are neither read nor removed
 			"extension": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -742,7 +718,8 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 
 func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
@@ -846,7 +823,7 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 	properties := compute.VirtualMachineScaleSet{
 		Name:                             &name,
 		Location:                         &location,
-		Tags:                             expandTags(tags),
+		Tags:                             expandTags(tags, meta),
 		Sku:                              sku,
 		VirtualMachineScaleSetProperties: &scaleSetProps,
 		Zones:                            zones,
@@ -869,6 +846,19 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		properties.Plan = plan
 	}
 
+	azureRMLockByName(name, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(name, virtualMachineScaleSetResourceName)
+
+	if !d.IsNewResource() {
+		existing, err := retrieveVirtualMachineScaleSetExtensionProfile(ctx, client, resGroup, name)
+		if err != nil {
+			return err
+		}
+
+		previous, _ := d.GetChange("extension")
+		properties.VirtualMachineProfile.ExtensionProfile = mergeAzureRMVirtualMachineScaleSetUnmanagedExtensions(extensions, existing, previous.(*schema.Set).List())
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
 	if err != nil {
 		return err
@@ -893,7 +883,8 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -1022,7 +1013,8 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 			}
 
 			if extensionProfile := properties.VirtualMachineProfile.ExtensionProfile; extensionProfile != nil {
-				extension, err := flattenAzureRmVirtualMachineScaleSetExtensionProfile(extensionProfile)
+				managed := azureRMVirtualMachineScaleSetExtensionNames(d.Get("extension").(*schema.Set).List())
+				extension, err := flattenAzureRmVirtualMachineScaleSetExtensionProfile(extensionProfile, managed)
 				if err != nil {
 					return fmt.Errorf("[DEBUG] Error setting Virtual Machine Scale Set Extension Profile error: %#v", err)
 				}
@@ -1041,14 +1033,44 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetTags(d, resp.Tags, meta)
 
 	return nil
 }
 
+// resourceArmVirtualMachineScaleSetImport imports all of the Extensions into the `extension` block, since otherwise only
+// the Extensions which are already defined in it are read
+func resourceArmVirtualMachineScaleSetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ArmClient).vmScaleSetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineScaleSetId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := retrieveVirtualMachineScaleSetExtensionProfile(ctx, client, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions, err := flattenAzureRmVirtualMachineScaleSetExtensionProfile(profile, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error flattening the Extensions for Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := d.Set("extension", extensions); err != nil {
+		return nil, fmt.Errorf("Error setting `extension`: %+v", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -1057,6 +1079,9 @@ func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interf
 	resGroup := id.ResourceGroup
 	name := id.Path["virtualMachineScaleSets"]
 
+	azureRMLockByName(name, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(name, virtualMachineScaleSetResourceName)
+
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -1446,13 +1471,21 @@ func flattenAzureRmVirtualMachineScaleSetSku(sku *compute.Sku) []interface{} {
 	return []interface{}{result}
 }
 
-func flattenAzureRmVirtualMachineScaleSetExtensionProfile(profile *compute.VirtualMachineScaleSetExtensionProfile) ([]map[string]interface{}, error) {
-	if profile.Extensions == nil {
+// flattenAzureRmVirtualMachineScaleSetExtensionProfile flattens the Extensions whose names are in `managed`,
+// or all of the Extensions when `managed` is nil
+func flattenAzureRmVirtualMachineScaleSetExtensionProfile(profile *compute.VirtualMachineScaleSetExtensionProfile, managed map[string]struct{}) ([]map[string]interface{}, error) {
+	if profile == nil || profile.Extensions == nil {
 		return nil, nil
 	}
 
 	result := make([]map[string]interface{}, 0, len(*profile.Extensions))
 	for _, extension := range *profile.Extensions {
+		if managed != nil {
+			if _, ok := managed[strings.ToLower(*extension.Name)]; !ok {
+				continue
+			}
+		}
+
 		e := make(map[string]interface{})
 		e["name"] = *extension.Name
 		properties := extension.VirtualMachineScaleSetExtensionProperties
@@ -1600,16 +1633,7 @@ func expandVirtualMachineScaleSetSku(d *schema.ResourceData) (*compute.Sku, erro
 }
 
 func expandAzureRmRollingUpgradePolicy(d *schema.ResourceData) *compute.RollingUpgradePolicy {
-	if config, ok := d.GetOk("rolling_upgrade_policy.0"); ok {
-		policy := config.(map[string]interface{})
-		return &compute.RollingUpgradePolicy{
-			MaxBatchInstancePercent:             utils.Int32(int32(policy["max_batch_instance_percent"].(int))),
-			MaxUnhealthyInstancePercent:         utils.Int32(int32(policy["max_unhealthy_instance_percent"].(int))),
-			MaxUnhealthyUpgradedInstancePercent: utils.Int32(int32(policy["max_unhealthy_upgraded_instance_percent"].(int))),
-			PauseTimeBetweenBatches:             utils.String(policy["pause_time_between_batches"].(string)),
-		}
-	}
-	return nil
+	return expandVirtualMachineScaleSetRollingUpgradePolicy(d.Get("rolling_upgrade_policy").([]interface{}))
 }
 
 func expandAzureRmVirtualMachineScaleSetNetworkProfile(d *schema.ResourceData) *compute.VirtualMachineScaleSetNetworkProfile {
@@ -1833,25 +1857,7 @@ func expandAzureRMVirtualMachineScaleSetsDiagnosticProfile(d *schema.ResourceDat
 }
 
 func expandAzureRmVirtualMachineScaleSetIdentity(d *schema.ResourceData) *compute.VirtualMachineScaleSetIdentity {
-	v := d.Get("identity")
-	identities := v.([]interface{})
-	identity := identities[0].(map[string]interface{})
-	identityType := compute.ResourceIdentityType(identity["type"].(string))
-
-	identityIds := make(map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue)
-	for _, id := range identity["identity_ids"].([]interface{}) {
-		identityIds[id.(string)] = &compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{}
-	}
-
-	vmssIdentity := compute.VirtualMachineScaleSetIdentity{
-		Type: identityType,
-	}
-
-	if vmssIdentity.Type == compute.ResourceIdentityTypeUserAssigned || vmssIdentity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned {
-		vmssIdentity.UserAssignedIdentities = identityIds
-	}
-
-	return &vmssIdentity
+	return expandVirtualMachineScaleSetIdentity(d.Get("identity").([]interface{}))
 }
 
 func expandAzureRMVirtualMachineScaleSetsStorageProfileOsDisk(d *schema.ResourceData) (*compute.VirtualMachineScaleSetOSDisk, error) {
@@ -2175,6 +2181,54 @@ func expandAzureRMVirtualMachineScaleSetExtensions(d *schema.ResourceData) (*com
 	}, nil
 }
 
+// mergeAzureRMVirtualMachineScaleSetUnmanagedExtensions returns the Extensions defined in the `extension` block along with
+// the existing Extensions which aren't managed by this resource - Extensions which were previously defined in the
+// `extension` block but have since been removed aren't retained
+func mergeAzureRMVirtualMachineScaleSetUnmanagedExtensions(extensions *compute.VirtualMachineScaleSetExtensionProfile, existing *compute.VirtualMachineScaleSetExtensionProfile, previous []interface{}) *compute.VirtualMachineScaleSetExtensionProfile {
+	if existing == nil || existing.Extensions == nil {
+		return extensions
+	}
+
+	managed := azureRMVirtualMachineScaleSetExtensionNames(previous)
+	merged := make([]compute.VirtualMachineScaleSetExtension, 0)
+	if extensions.Extensions != nil {
+		for _, extension := range *extensions.Extensions {
+			managed[strings.ToLower(*extension.Name)] = struct{}{}
+			merged = append(merged, extension)
+		}
+	}
+
+	for _, extension := range *existing.Extensions {
+		if extension.Name == nil {
+			continue
+		}
+
+		if _, ok := managed[strings.ToLower(*extension.Name)]; !ok {
+			log.Printf("[DEBUG] Retaining the Extension %q which isn't managed by this Virtual Machine Scale Set", *extension.Name)
+			merged = append(merged, extension)
+		}
+	}
+
+	return &compute.VirtualMachineScaleSetExtensionProfile{
+		Extensions: &merged,
+	}
+}
+
+// azureRMVirtualMachineScaleSetExtensionNames returns the (lower-cased) names of the Extensions in the `extension` block
+func azureRMVirtualMachineScaleSetExtensionNames(input []interface{}) map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		extension := v.(map[string]interface{})
+		names[strings.ToLower(extension["name"].(string))] = struct{}{}
+	}
+
+	return names
+}
+
 func expandAzureRmVirtualMachineScaleSetPlan(d *schema.ResourceData) (*compute.Plan, error) {
 	planConfigs := d.Get("plan").(*schema.Set).List()
 
@@ -2201,14 +2255,6 @@ func flattenAzureRmVirtualMachineScaleSetPlan(plan *compute.Plan) []interface{}
 	return []interface{}{result}
 }
 
-// When upgrade_policy_mode is not Rolling, we will just ignore rolling_upgrade_policy (returns true).
-func azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff(k, _, new string, d *schema.ResourceData) bool {
-	if k == "rolling_upgrade_policy.#" && new == "0" {
-		return strings.ToLower(d.Get("upgrade_policy_mode").(string)) != "rolling"
-	}
-	return false
-}
-
 // Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling.
 func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
 	mode := d.Get("upgrade_policy_mode").(string)
@@ -0,0 +1,283 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualMachineScaleSetExtension() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineScaleSetExtensionCreateUpdate,
+		Read:   resourceArmVirtualMachineScaleSetExtensionRead,
+		Update: resourceArmVirtualMachineScaleSetExtensionCreateUpdate,
+		Delete: resourceArmVirtualMachineScaleSetExtensionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"virtual_machine_scale_set_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateVirtualMachineScaleSetId,
+			},
+
+			"publisher": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type_handler_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"auto_upgrade_minor_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"force_update_tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"protected_settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"provision_after_extensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineScaleSetExtensionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	scaleSetId, err := resourceid.ParseVirtualMachineScaleSetId(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, scaleSetId.ResourceGroup, scaleSetId.Name, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetId.Name, scaleSetId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_scale_set_extension", *existing.ID)
+		}
+	}
+
+	provisionAfterExtensions := utils.ExpandStringArray(d.Get("provision_after_extensions").([]interface{}))
+	for _, v := range *provisionAfterExtensions {
+		if v == name {
+			return fmt.Errorf("Extension %q cannot be provisioned after itself - please remove it from `provision_after_extensions`", name)
+		}
+	}
+
+	props := compute.VirtualMachineScaleSetExtensionProperties{
+		Publisher:                utils.String(d.Get("publisher").(string)),
+		Type:                     utils.String(d.Get("type").(string)),
+		TypeHandlerVersion:       utils.String(d.Get("type_handler_version").(string)),
+		AutoUpgradeMinorVersion:  utils.Bool(d.Get("auto_upgrade_minor_version").(bool)),
+		ProvisionAfterExtensions: provisionAfterExtensions,
+	}
+
+	if v := d.Get("force_update_tag").(string); v != "" {
+		props.ForceUpdateTag = utils.String(v)
+	}
+
+	if settingsString := d.Get("settings").(string); settingsString != "" {
+		settings, err := structure.ExpandJsonFromString(settingsString)
+		if err != nil {
+			return fmt.Errorf("Error parsing `settings`: %+v", err)
+		}
+		props.Settings = &settings
+	}
+
+	if protectedSettingsString := d.Get("protected_settings").(string); protectedSettingsString != "" {
+		protectedSettings, err := structure.ExpandJsonFromString(protectedSettingsString)
+		if err != nil {
+			return fmt.Errorf("Error parsing `protected_settings`: %+v", err)
+		}
+		props.ProtectedSettings = &protectedSettings
+	}
+
+	extension := compute.VirtualMachineScaleSetExtension{
+		Name: utils.String(name),
+		VirtualMachineScaleSetExtensionProperties: &props,
+	}
+
+	// the Extension is part of the model of the Virtual Machine Scale Set, which can't be updated concurrently
+	azureRMLockByName(scaleSetId.Name, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(scaleSetId.Name, virtualMachineScaleSetResourceName)
+
+	log.Printf("[DEBUG] Creating/Updating Extension %q (Virtual Machine Scale Set %q / Resource Group %q)..", name, scaleSetId.Name, scaleSetId.ResourceGroup)
+	future, err := client.CreateOrUpdate(ctx, scaleSetId.ResourceGroup, scaleSetId.Name, name, extension)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetId.Name, scaleSetId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetId.Name, scaleSetId.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Created/Updated Extension %q (Virtual Machine Scale Set %q / Resource Group %q).", name, scaleSetId.Name, scaleSetId.ResourceGroup)
+
+	read, err := client.Get(ctx, scaleSetId.ResourceGroup, scaleSetId.Name, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, scaleSetId.Name, scaleSetId.ResourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error retrieving Extension %q (Virtual Machine Scale Set %q / Resource Group %q): `id` was nil", name, scaleSetId.Name, scaleSetId.ResourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineScaleSetExtensionRead(d, meta)
+}
+
+func resourceArmVirtualMachineScaleSetExtensionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineScaleSetExtensionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Extension %q (Virtual Machine Scale Set %q / Resource Group %q) was not found - removing from state!", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	}
+
+	scaleSetId := resourceid.NewVirtualMachineScaleSetId(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+
+	d.Set("name", id.Name)
+	d.Set("virtual_machine_scale_set_id", scaleSetId.String())
+
+	if props := resp.VirtualMachineScaleSetExtensionProperties; props != nil {
+		d.Set("auto_upgrade_minor_version", props.AutoUpgradeMinorVersion)
+		d.Set("force_update_tag", props.ForceUpdateTag)
+		d.Set("publisher", props.Publisher)
+		d.Set("type", props.Type)
+		d.Set("type_handler_version", props.TypeHandlerVersion)
+
+		if err := d.Set("provision_after_extensions", utils.FlattenStringArray(props.ProvisionAfterExtensions)); err != nil {
+			return fmt.Errorf("Error setting `provision_after_extensions`: %+v", err)
+		}
+
+		settings := ""
+		if props.Settings != nil {
+			settingsVal, ok := props.Settings.(map[string]interface{})
+			if ok {
+				settingsJson, err := structure.FlattenJsonToString(settingsVal)
+				if err != nil {
+					return fmt.Errorf("Error flattening `settings`: %+v", err)
+				}
+				settings = settingsJson
+			}
+		}
+		d.Set("settings", settings)
+
+		// protected_settings isn't returned by the API for security reasons
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineScaleSetExtensionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineScaleSetExtensionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(id.VirtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+
+	log.Printf("[DEBUG] Deleting Extension %q (Virtual Machine Scale Set %q / Resource Group %q)..", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Deleted Extension %q (Virtual Machine Scale Set %q / Resource Group %q).", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup)
+
+	return nil
+}
@@ -0,0 +1,399 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_upgrade_minor_version", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualMachineScaleSetExtension_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_machine_scale_set_extension"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_update(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force_update_tag", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"protected_settings",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_provisionAfterExtensions(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.second"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_provisionAfterExtensions(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists("azurerm_virtual_machine_scale_set_extension.first"),
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provision_after_extensions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "provision_after_extensions.0", "first"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_legacyScaleSet(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_legacyScaleSet(ri, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine_scale_set.test", "extension.#", "1"),
+				),
+			},
+			{
+				// updating the Scale Set mustn't remove the Extension managed by the separate resource
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_legacyScaleSet(ri, location, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_virtual_machine_scale_set.test", "extension.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseVirtualMachineScaleSetExtensionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vmScaleSetExtensionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Extension %q (Virtual Machine Scale Set %q / Resource Group %q) does not exist", id.Name, id.VirtualMachineScaleSetName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vmScaleSetExtensionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vmScaleSetExtensionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_scale_set_extension" {
+			continue
+		}
+
+		id, err := resourceid.ParseVirtualMachineScaleSetExtensionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Machine Scale Set Extension still exists:\n%#v", resp.VirtualMachineScaleSetExtensionProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_basic(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachineScaleSet_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "acctestExt-%d"
+  virtual_machine_scale_set_id = "${azurerm_linux_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+
+  settings = <<SETTINGS
+	{
+		"commandToExecute": "echo $HOSTNAME"
+	}
+SETTINGS
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineScaleSetExtension_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "import" {
+  name                         = "${azurerm_virtual_machine_scale_set_extension.test.name}"
+  virtual_machine_scale_set_id = "${azurerm_virtual_machine_scale_set_extension.test.virtual_machine_scale_set_id}"
+  publisher                    = "${azurerm_virtual_machine_scale_set_extension.test.publisher}"
+  type                         = "${azurerm_virtual_machine_scale_set_extension.test.type}"
+  type_handler_version         = "${azurerm_virtual_machine_scale_set_extension.test.type_handler_version}"
+  settings                     = "${azurerm_virtual_machine_scale_set_extension.test.settings}"
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_updated(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachineScaleSet_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "acctestExt-%d"
+  virtual_machine_scale_set_id = "${azurerm_linux_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+  force_update_tag             = "second"
+
+  settings = <<SETTINGS
+	{
+		"commandToExecute": "echo $HOSTNAME"
+	}
+SETTINGS
+
+  protected_settings = <<SETTINGS
+	{
+		"commandToExecute": "echo hello"
+	}
+SETTINGS
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_provisionAfterExtensions(rInt int, location string) string {
+	template := testAccAzureRMLinuxVirtualMachineScaleSet_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "first" {
+  name                         = "first"
+  virtual_machine_scale_set_id = "${azurerm_linux_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+
+  settings = <<SETTINGS
+	{
+		"commandToExecute": "echo $HOSTNAME"
+	}
+SETTINGS
+}
+
+resource "azurerm_virtual_machine_scale_set_extension" "second" {
+  name                         = "second"
+  virtual_machine_scale_set_id = "${azurerm_linux_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.OSTCExtensions"
+  type                         = "VMAccessForLinux"
+  type_handler_version         = "1.5"
+  provision_after_extensions   = ["${azurerm_virtual_machine_scale_set_extension.first.name}"]
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_legacyScaleSet(rInt int, location string, capacity int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Manual"
+
+  sku {
+    name     = "Standard_D1_v2"
+    tier     = "Standard"
+    capacity = %[3]d
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile-%[1]d"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      primary   = true
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  storage_profile_os_disk {
+    name              = ""
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  extension {
+    name                 = "first"
+    publisher            = "Microsoft.OSTCExtensions"
+    type                 = "VMAccessForLinux"
+    type_handler_version = "1.5"
+  }
+}
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "acctestExt-%[1]d"
+  virtual_machine_scale_set_id = "${azurerm_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+
+  settings = <<SETTINGS
+	{
+		"commandToExecute": "echo $HOSTNAME"
+	}
+SETTINGS
+}
+`, rInt, location, capacity)
+}
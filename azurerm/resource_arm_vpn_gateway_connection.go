@@ -0,0 +1,281 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVpnGatewayConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnGatewayConnectionCreateUpdate,
+		Read:   resourceArmVpnGatewayConnectionRead,
+		Update: resourceArmVpnGatewayConnectionCreateUpdate,
+		Delete: resourceArmVpnGatewayConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"vpn_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateVpnGatewayId,
+			},
+
+			"remote_vpn_site_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateVpnSiteId,
+			},
+
+			"routing_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+
+			"connection_bandwidth_in_mbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"shared_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(network.IKEv2),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IKEv1),
+					string(network.IKEv2),
+				}, false),
+			},
+
+			"enable_bgp": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_rate_limiting": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enable_internet_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ipsec_policy": virtualNetworkGatewayConnectionIpsecPolicySchema(),
+
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVpnGatewayConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnGatewayConnectionClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	gatewayId, err := resourceid.ParseVpnGatewayId(d.Get("vpn_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayId.Name, gatewayId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_vpn_gateway_connection", *existing.ID)
+		}
+	}
+
+	props := network.VpnConnectionProperties{
+		RemoteVpnSite: &network.SubResource{
+			ID: utils.String(d.Get("remote_vpn_site_id").(string)),
+		},
+		VpnConnectionProtocolType: network.VirtualNetworkGatewayConnectionProtocol(d.Get("protocol").(string)),
+		EnableBgp:                 utils.Bool(d.Get("enable_bgp").(bool)),
+		EnableRateLimiting:        utils.Bool(d.Get("enable_rate_limiting").(bool)),
+		EnableInternetSecurity:    utils.Bool(d.Get("enable_internet_security").(bool)),
+		IpsecPolicies:             expandArmVirtualNetworkGatewayConnectionIpsecPolicies(d.Get("ipsec_policy").([]interface{})),
+	}
+
+	if v, ok := d.GetOkExists("routing_weight"); ok {
+		props.RoutingWeight = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("connection_bandwidth_in_mbps"); ok {
+		props.ConnectionBandwidth = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("shared_key"); ok {
+		props.SharedKey = utils.String(v.(string))
+	}
+
+	connection := network.VpnConnection{
+		Name:                    utils.String(name),
+		VpnConnectionProperties: &props,
+	}
+
+	azureRMLockByName(gatewayId.Name, vpnGatewayResourceName)
+	defer azureRMUnlockByName(gatewayId.Name, vpnGatewayResourceName)
+
+	log.Printf("[DEBUG] Creating/Updating Connection %q (VPN Gateway %q / Resource Group %q)..", name, gatewayId.Name, gatewayId.ResourceGroup)
+	future, err := client.CreateOrUpdate(ctx, gatewayId.ResourceGroup, gatewayId.Name, name, connection)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayId.Name, gatewayId.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q (VPN Gateway %q / Resource Group %q): %+v", name, gatewayId.Name, gatewayId.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Created/Updated Connection %q (VPN Gateway %q / Resource Group %q).", name, gatewayId.Name, gatewayId.ResourceGroup)
+
+	id := resourceid.NewVpnGatewayConnectionId(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, name)
+	d.SetId(id.String())
+
+	return resourceArmVpnGatewayConnectionRead(d, meta)
+}
+
+func resourceArmVpnGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnGatewayConnectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVpnGatewayConnectionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Connection %q (VPN Gateway %q / Resource Group %q) was not found - removing from state!", id.Name, id.VpnGatewayName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Connection %q (VPN Gateway %q / Resource Group %q): %+v", id.Name, id.VpnGatewayName, id.ResourceGroup, err)
+	}
+
+	gatewayId := resourceid.NewVpnGatewayId(id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName)
+
+	d.Set("name", id.Name)
+	d.Set("vpn_gateway_id", gatewayId.String())
+
+	if props := resp.VpnConnectionProperties; props != nil {
+		remoteVpnSiteId := ""
+		if props.RemoteVpnSite != nil && props.RemoteVpnSite.ID != nil {
+			remoteVpnSiteId = *props.RemoteVpnSite.ID
+		}
+		d.Set("remote_vpn_site_id", remoteVpnSiteId)
+
+		routingWeight := 0
+		if props.RoutingWeight != nil {
+			routingWeight = int(*props.RoutingWeight)
+		}
+		d.Set("routing_weight", routingWeight)
+
+		connectionBandwidth := 0
+		if props.ConnectionBandwidth != nil {
+			connectionBandwidth = int(*props.ConnectionBandwidth)
+		}
+		d.Set("connection_bandwidth_in_mbps", connectionBandwidth)
+
+		d.Set("shared_key", props.SharedKey)
+		d.Set("protocol", string(props.VpnConnectionProtocolType))
+		d.Set("enable_bgp", props.EnableBgp)
+		d.Set("enable_rate_limiting", props.EnableRateLimiting)
+		d.Set("enable_internet_security", props.EnableInternetSecurity)
+		d.Set("connection_status", string(props.ConnectionStatus))
+
+		if err := d.Set("ipsec_policy", flattenArmVirtualNetworkGatewayConnectionIpsecPolicies(props.IpsecPolicies)); err != nil {
+			return fmt.Errorf("Error setting `ipsec_policy`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVpnGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnGatewayConnectionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVpnGatewayConnectionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.VpnGatewayName, vpnGatewayResourceName)
+	defer azureRMUnlockByName(id.VpnGatewayName, vpnGatewayResourceName)
+
+	log.Printf("[DEBUG] Deleting Connection %q (VPN Gateway %q / Resource Group %q)..", id.Name, id.VpnGatewayName, id.ResourceGroup)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Connection %q (VPN Gateway %q / Resource Group %q): %+v", id.Name, id.VpnGatewayName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Connection %q (VPN Gateway %q / Resource Group %q): %+v", id.Name, id.VpnGatewayName, id.ResourceGroup, err)
+		}
+	}
+	log.Printf("[DEBUG] Deleted Connection %q (VPN Gateway %q / Resource Group %q).", id.Name, id.VpnGatewayName, id.ResourceGroup)
+
+	return nil
+}
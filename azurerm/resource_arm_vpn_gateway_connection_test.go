@@ -0,0 +1,219 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnGatewayConnection_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGatewayConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "IKEv2"),
+					resource.TestCheckResourceAttrSet(resourceName, "shared_key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnGatewayConnection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_vpn_gateway_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGatewayConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVpnGatewayConnection_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_vpn_gateway_connection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnGatewayConnection_ipsecPolicy(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGatewayConnection_ipsecPolicy(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ipsec_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shared_key", "4-v3ry-53cr37-1p53c-5h4r3d-k3y"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnGatewayConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseVpnGatewayConnectionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vpnGatewayConnectionClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Connection %q (VPN Gateway %q / Resource Group %q) does not exist", id.Name, id.VpnGatewayName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vpnGatewayConnectionClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnGatewayConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vpnGatewayConnectionClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_gateway_connection" {
+			continue
+		}
+
+		id, err := resourceid.ParseVpnGatewayConnectionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("VPN Gateway Connection still exists:\n%#v", resp.VpnConnectionProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnGatewayConnection_template(rInt int, location string) string {
+	template := testAccAzureRMVpnGateway_basic(rInt, location, 1)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "203.0.113.10"
+  address_prefixes    = ["10.100.0.0/24"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnGatewayConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMVpnGatewayConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctestvpnconn-%d"
+  vpn_gateway_id     = "${azurerm_vpn_gateway.test.id}"
+  remote_vpn_site_id = "${azurerm_vpn_site.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnGatewayConnection_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVpnGatewayConnection_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection" "import" {
+  name               = "${azurerm_vpn_gateway_connection.test.name}"
+  vpn_gateway_id     = "${azurerm_vpn_gateway_connection.test.vpn_gateway_id}"
+  remote_vpn_site_id = "${azurerm_vpn_gateway_connection.test.remote_vpn_site_id}"
+}
+`, template)
+}
+
+func testAccAzureRMVpnGatewayConnection_ipsecPolicy(rInt int, location string) string {
+	template := testAccAzureRMVpnGatewayConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctestvpnconn-%d"
+  vpn_gateway_id     = "${azurerm_vpn_gateway.test.id}"
+  remote_vpn_site_id = "${azurerm_vpn_site.test.id}"
+  shared_key         = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+
+  ipsec_policy {
+    dh_group         = "DHGroup14"
+    ike_encryption   = "AES256"
+    ike_integrity    = "SHA256"
+    ipsec_encryption = "AES256"
+    ipsec_integrity  = "SHA256"
+    pfs_group        = "PFS2048"
+    sa_datasize      = 102400000
+    sa_lifetime      = 27000
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnGateway_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGateway_basic(ri, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_unit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_settings.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "bgp_settings.0.bgp_peering_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMVpnGateway_basic(ri, location, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_unit", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnGateway_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_vpn_gateway.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnGateway_basic(ri, location, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnGatewayExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVpnGateway_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_vpn_gateway"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnGatewayExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseVpnGatewayId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vpnGatewayClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: VPN Gateway %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vpnGatewayClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnGatewayDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vpnGatewayClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_gateway" {
+			continue
+		}
+
+		id, err := resourceid.ParseVpnGatewayId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("VPN Gateway still exists:\n%#v", resp.VpnGatewayProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnGateway_basic(rInt int, location string, scaleUnit int) string {
+	template := testAccAzureRMVirtualHub_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway" "test" {
+  name                = "acctestvpngw-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_hub_id      = "${azurerm_virtual_hub.test.id}"
+  scale_unit          = %d
+}
+`, template, rInt, scaleUnit)
+}
+
+func testAccAzureRMVpnGateway_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVpnGateway_basic(rInt, location, 1)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway" "import" {
+  name                = "${azurerm_vpn_gateway.test.name}"
+  resource_group_name = "${azurerm_vpn_gateway.test.resource_group_name}"
+  location            = "${azurerm_vpn_gateway.test.location}"
+  virtual_hub_id      = "${azurerm_vpn_gateway.test.virtual_hub_id}"
+}
+`, template)
+}
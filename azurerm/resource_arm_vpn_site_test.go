@@ -0,0 +1,223 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVpnSite_basic(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "203.0.113.10"),
+					resource.TestCheckResourceAttr(resourceName, "address_prefixes.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnSite_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVpnSite_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_vpn_site"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVpnSite_complete(t *testing.T) {
+	resourceName := "azurerm_vpn_site.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVpnSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVpnSite_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVpnSite_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVpnSiteExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "device_vendor", "Cisco"),
+					resource.TestCheckResourceAttr(resourceName, "device_model", "ISR4331"),
+					resource.TestCheckResourceAttr(resourceName, "link_speed_in_mbps", "50"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_settings.0.asn", "65010"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVpnSiteExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseVpnSiteId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vpnSiteClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: VPN Site %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vpnSiteClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVpnSiteDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vpnSiteClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_vpn_site" {
+			continue
+		}
+
+		id, err := resourceid.ParseVpnSiteId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("VPN Site still exists:\n%#v", resp.VpnSiteProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVpnSite_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualWan_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "203.0.113.10"
+  address_prefixes    = ["10.100.0.0/24"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVpnSite_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVpnSite_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "import" {
+  name                = "${azurerm_vpn_site.test.name}"
+  resource_group_name = "${azurerm_vpn_site.test.resource_group_name}"
+  location            = "${azurerm_vpn_site.test.location}"
+  virtual_wan_id      = "${azurerm_vpn_site.test.virtual_wan_id}"
+  ip_address          = "${azurerm_vpn_site.test.ip_address}"
+  address_prefixes    = ["10.100.0.0/24"]
+}
+`, template)
+}
+
+func testAccAzureRMVpnSite_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualWan_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_site" "test" {
+  name                = "acctestvpnsite-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  ip_address          = "203.0.113.10"
+  address_prefixes    = ["10.100.0.0/24", "10.101.0.0/24"]
+  device_vendor       = "Cisco"
+  device_model        = "ISR4331"
+  link_speed_in_mbps  = 50
+
+  bgp_settings {
+    asn                 = 65010
+    bgp_peering_address = "10.100.0.254"
+    peer_weight         = 0
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
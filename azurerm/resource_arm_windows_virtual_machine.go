@@ -0,0 +1,578 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWindowsVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWindowsVirtualMachineCreate,
+		Read:   resourceArmWindowsVirtualMachineRead,
+		Update: resourceArmWindowsVirtualMachineUpdate,
+		Delete: resourceArmWindowsVirtualMachineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"network_interface_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceid.ValidateNetworkInterfaceId,
+				},
+			},
+
+			"os_disk": virtualMachineOSDiskSchema(),
+
+			"admin_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"allow_extension_operations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"availability_set_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     resourceid.ValidateAvailabilitySetId,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"zones"},
+			},
+
+			"boot_diagnostics": virtualMachineBootDiagnosticsSchema(),
+
+			"computer_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWindowsVirtualMachineComputerName,
+			},
+
+			"custom_data": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				StateFunc: userDataStateFunc,
+			},
+
+			"additional_unattend_content": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+
+						"setting": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.AutoLogon),
+								string(compute.FirstLogonCommands),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"enable_automatic_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"identity": virtualMachineIdentitySchema(),
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"None",
+					"Windows_Client",
+					"Windows_Server",
+				}, false),
+			},
+
+			"plan": virtualMachinePlanSchema(),
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"secret": virtualMachineSecretSchema(),
+
+			"source_image_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"source_image_reference": virtualMachineSourceImageReferenceSchema(),
+
+			"zones": singleZonesSchema(),
+
+			"timezone": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     validateAzureVirtualMachineTimeZone(),
+			},
+
+			"winrm_listener": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.HTTP),
+								string(compute.HTTPS),
+							}, false),
+						},
+
+						"certificate_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateKeyVaultChildId,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmWindowsVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if requireResourcesToBeImported {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_windows_virtual_machine", *existing.ID)
+		}
+	}
+
+	imageReference, err := expandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return err
+	}
+
+	computerName := d.Get("computer_name").(string)
+	if computerName == "" {
+		// the Computer Name of a Windows Virtual Machine is limited to 15 characters
+		if _, errs := validateWindowsVirtualMachineComputerName(name, "computer_name"); len(errs) > 0 {
+			return fmt.Errorf("A `computer_name` must be specified since the `name` %q isn't a valid Computer Name: %+v", name, errs[0])
+		}
+
+		computerName = name
+	}
+
+	networkInterfaceIds := d.Get("network_interface_ids").([]interface{})
+	// the first Network Interface is the Primary
+	primaryNetworkInterfaceId := networkInterfaceIds[0].(string)
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	params := compute.VirtualMachine{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		Identity: expandVirtualMachineIdentity(d.Get("identity").([]interface{})),
+		Plan:     expandVirtualMachinePlan(d.Get("plan").([]interface{})),
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+			},
+			OsProfile: &compute.OSProfile{
+				AdminPassword:            utils.String(d.Get("admin_password").(string)),
+				AdminUsername:            utils.String(d.Get("admin_username").(string)),
+				AllowExtensionOperations: utils.Bool(d.Get("allow_extension_operations").(bool)),
+				ComputerName:             utils.String(computerName),
+				WindowsConfiguration: &compute.WindowsConfiguration{
+					AdditionalUnattendContent: expandWindowsVirtualMachineAdditionalUnattendContent(d.Get("additional_unattend_content").([]interface{})),
+					EnableAutomaticUpdates:    utils.Bool(d.Get("enable_automatic_updates").(bool)),
+					ProvisionVMAgent:          utils.Bool(d.Get("provision_vm_agent").(bool)),
+					WinRM:                     expandVirtualMachineWinRMListeners(d.Get("winrm_listener").(*schema.Set).List()),
+				},
+				Secrets: expandVirtualMachineSecrets(d.Get("secret").([]interface{})),
+			},
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: expandVirtualMachineNetworkInterfaceIDs(networkInterfaceIds, primaryNetworkInterfaceId),
+			},
+			StorageProfile: &compute.StorageProfile{
+				ImageReference: imageReference,
+				OsDisk:         expandVirtualMachineOSDisk(d.Get("os_disk").([]interface{}), compute.Windows),
+			},
+			DiagnosticsProfile: expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+		},
+		Tags:  expandTags(d.Get("tags").(map[string]interface{}), meta),
+		Zones: expandZones(d.Get("zones").([]interface{})),
+	}
+
+	if v := d.Get("license_type").(string); v != "" {
+		params.VirtualMachineProperties.LicenseType = utils.String(v)
+	}
+
+	if v := d.Get("timezone").(string); v != "" {
+		params.VirtualMachineProperties.OsProfile.WindowsConfiguration.TimeZone = utils.String(v)
+	}
+
+	if v := d.Get("custom_data").(string); v != "" {
+		params.VirtualMachineProperties.OsProfile.CustomData = utils.String(base64Encode(v))
+	}
+
+	if v := d.Get("availability_set_id").(string); v != "" {
+		params.VirtualMachineProperties.AvailabilitySet = &compute.SubResource{
+			ID: utils.String(v),
+		}
+	}
+
+	azureRMLockByName(name, virtualMachineResourceName)
+	defer azureRMUnlockByName(name, virtualMachineResourceName)
+
+	log.Printf("[DEBUG] Creating Windows Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
+	if err != nil {
+		return fmt.Errorf("Error creating Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Created Windows Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): `id` was nil", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	ipAddress, err := determineVirtualMachineIPAddress(ctx, meta, read.VirtualMachineProperties)
+	if err != nil {
+		return fmt.Errorf("Error determining IP Address for Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetConnInfo(map[string]string{
+		"type": "winrm",
+		"host": ipAddress,
+	})
+
+	return resourceArmWindowsVirtualMachineRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Windows Virtual Machine %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("zones", resp.Zones)
+
+	if err := d.Set("identity", flattenAzureRmVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if err := d.Set("plan", flattenAzureRmVirtualMachinePlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	props := resp.VirtualMachineProperties
+	if props == nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): `properties` was nil", id.Name, id.ResourceGroup)
+	}
+
+	availabilitySetId := ""
+	if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+		availabilitySetId = *props.AvailabilitySet.ID
+	}
+	d.Set("availability_set_id", availabilitySetId)
+
+	bootDiagnostics := make([]interface{}, 0)
+	if profile := props.DiagnosticsProfile; profile != nil && profile.BootDiagnostics != nil {
+		// Boot Diagnostics are returned as disabled rather than omitted once they've been removed
+		if enabled := profile.BootDiagnostics.Enabled; enabled != nil && *enabled {
+			bootDiagnostics = flattenAzureRmVirtualMachineDiagnosticsProfile(profile.BootDiagnostics)
+		}
+	}
+	if err := d.Set("boot_diagnostics", bootDiagnostics); err != nil {
+		return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+	}
+
+	if profile := props.HardwareProfile; profile != nil {
+		d.Set("size", string(profile.VMSize))
+	}
+
+	if profile := props.NetworkProfile; profile != nil && profile.NetworkInterfaces != nil {
+		if err := d.Set("network_interface_ids", flattenAzureRmVirtualMachineNetworkInterfaces(profile)); err != nil {
+			return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+		}
+	}
+
+	if profile := props.OsProfile; profile != nil {
+		d.Set("admin_username", profile.AdminUsername)
+		d.Set("computer_name", profile.ComputerName)
+
+		allowExtensionOperations := true
+		if profile.AllowExtensionOperations != nil {
+			allowExtensionOperations = *profile.AllowExtensionOperations
+		}
+		d.Set("allow_extension_operations", allowExtensionOperations)
+
+		if config := profile.WindowsConfiguration; config != nil {
+			d.Set("enable_automatic_updates", config.EnableAutomaticUpdates)
+			d.Set("provision_vm_agent", config.ProvisionVMAgent)
+			d.Set("timezone", config.TimeZone)
+
+			// the `content` of the Additional Unattend Content isn't returned by the API, so we can only use the config
+			if err := d.Set("additional_unattend_content", d.Get("additional_unattend_content")); err != nil {
+				return fmt.Errorf("Error setting `additional_unattend_content`: %+v", err)
+			}
+
+			if err := d.Set("winrm_listener", flattenVirtualMachineWinRMListeners(config.WinRM)); err != nil {
+				return fmt.Errorf("Error setting `winrm_listener`: %+v", err)
+			}
+		}
+
+		if err := d.Set("secret", flattenAzureRmVirtualMachineOsProfileSecrets(profile.Secrets)); err != nil {
+			return fmt.Errorf("Error setting `secret`: %+v", err)
+		}
+	}
+
+	if profile := props.StorageProfile; profile != nil {
+		var diskInfo *compute.Disk
+		if profile.OsDisk != nil {
+			diskInfo, err = resourceArmVirtualMachineGetManagedDiskInfo(ctx, profile.OsDisk.ManagedDisk, meta)
+			if err != nil {
+				return fmt.Errorf("Error retrieving the OS Disk for Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+			}
+		}
+
+		if err := d.Set("os_disk", flattenVirtualMachineOSDisk(profile.OsDisk, diskInfo)); err != nil {
+			return fmt.Errorf("Error setting `os_disk`: %+v", err)
+		}
+
+		sourceImageId := ""
+		if profile.ImageReference != nil && profile.ImageReference.ID != nil {
+			sourceImageId = *profile.ImageReference.ID
+		}
+		d.Set("source_image_id", sourceImageId)
+
+		if err := d.Set("source_image_reference", flattenVirtualMachineSourceImageReference(profile.ImageReference)); err != nil {
+			return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+		}
+	}
+
+	d.Set("license_type", props.LicenseType)
+	d.Set("virtual_machine_id", props.VMID)
+
+	flattenAndSetTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	update, err := expandVirtualMachineUpdate(ctx, d, meta, compute.Windows, existing)
+	if err != nil {
+		return err
+	}
+
+	if err := applyVirtualMachineUpdate(ctx, meta, id.ResourceGroup, id.Name, d.Get("os_disk.0.id").(string), *update); err != nil {
+		return err
+	}
+
+	return resourceArmWindowsVirtualMachineRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseVirtualMachineId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineResourceName)
+
+	if err := deleteVirtualMachineAndOSDisk(ctx, meta, id.ResourceGroup, id.Name); err != nil {
+		return fmt.Errorf("Error deleting Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func validateWindowsVirtualMachineComputerName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if len(v) > 15 {
+		errors = append(errors, fmt.Errorf("%q can be at most 15 characters, got %d", k, len(v)))
+	}
+
+	if strings.HasPrefix(v, "_") || strings.HasSuffix(v, ".") || strings.HasSuffix(v, "-") {
+		errors = append(errors, fmt.Errorf("%q cannot begin with an underscore or end with a period or hyphen", k))
+	}
+
+	if _, err := strconv.Atoi(v); err == nil {
+		errors = append(errors, fmt.Errorf("%q cannot contain only numbers", k))
+	}
+
+	return warnings, errors
+}
+
+func expandWindowsVirtualMachineAdditionalUnattendContent(input []interface{}) *[]compute.AdditionalUnattendContent {
+	output := make([]compute.AdditionalUnattendContent, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		output = append(output, compute.AdditionalUnattendContent{
+			SettingName: compute.SettingNames(raw["setting"].(string)),
+			Content:     utils.String(raw["content"].(string)),
+
+			// no other possible values
+			PassName:      compute.OobeSystem,
+			ComponentName: compute.MicrosoftWindowsShellSetup,
+		})
+	}
+
+	return &output
+}
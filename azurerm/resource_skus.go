@@ -0,0 +1,59 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// validateResourceSkuAvailabilityDiff returns a CustomizeDiffFunc which validates that the SKU in `skuField` is available
+// for the Resource Type in the `location` and `zones` of the resource. This is opt-in via the `validate_resource_skus`
+// field in the Provider block, since it requires listing all of the Resource SKUs available to the Subscription.
+func validateResourceSkuAvailabilityDiff(resourceType string, skuField string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*ArmClient)
+		if !ok || client == nil || !client.validateResourceSkus {
+			return nil
+		}
+
+		// existing resources are only validated when the SKU or placement changes
+		if d.Id() != "" && !d.HasChange(skuField) && !d.HasChange("location") && !d.HasChange("zones") {
+			return nil
+		}
+
+		// values which aren't known until apply (e.g. interpolated from other resources) can't be validated
+		for _, field := range []string{skuField, "location", "zones"} {
+			if !d.NewValueKnown(field) {
+				log.Printf("[DEBUG] Skipping validation of the %q SKU since %q isn't known during the plan", resourceType, field)
+				return nil
+			}
+		}
+
+		name := d.Get(skuField).(string)
+		location := azure.NormalizeLocation(d.Get("location").(string))
+		zones := *utils.ExpandStringArray(d.Get("zones").([]interface{}))
+		if name == "" || location == "" {
+			return nil
+		}
+
+		// there's no Timeout available during the plan, so this uses the same default as the `azurerm_resource_skus` Data Source
+		ctx, cancel := context.WithTimeout(client.StopContext, 5*time.Minute)
+		defer cancel()
+
+		skus, err := client.listResourceSkus(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := azure.ValidateResourceSkuAvailability(skus, resourceType, name, location, zones); err != nil {
+			return fmt.Errorf("Error validating `%s`: %+v", skuField, err)
+		}
+
+		return nil
+	}
+}
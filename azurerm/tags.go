@@ -1,10 +1,16 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net/http"
 	"strings"
 
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func tagsSchema() *schema.Schema {
@@ -67,18 +73,185 @@ func validateAzureRMTags(v interface{}, _ string) (warnings []string, errors []e
 	return warnings, errors
 }
 
-func expandTags(tagsMap map[string]interface{}) map[string]*string {
-	output := make(map[string]*string, len(tagsMap))
+func expandTags(tagsMap map[string]interface{}, meta interface{}) map[string]*string {
+	client := meta.(*ArmClient)
+	output := make(map[string]*string, len(tagsMap)+len(client.defaultTags))
 
-	for i, v := range tagsMap {
-		//Validate should have ignored this error already
-		value, _ := tagValueToString(v)
-		output[i] = &value
+	// the tags defined on the resource take precedence over the Provider's default tags
+	for k, v := range client.defaultTags {
+		value := v
+		output[k] = &value
+	}
+
+	for k, v := range expandTagsWithoutDefaults(tagsMap) {
+		output[k] = v
+	}
+
+	// the ignored tags are managed outside of Terraform, so those assigned to the resource prior to
+	// this update are sent back to Azure to ensure these aren't removed, see `preserveIgnoredTagsForResource`
+	for k, v := range client.existingIgnoredTags {
+		if !containsTagKey(output, k) {
+			output[k] = v
+		}
 	}
 
 	return output
 }
 
+// preserveIgnoredTagsForResource wraps the Update function of a Resource which supports tags, so that the ignored tags
+// currently assigned to it are retrieved before it's updated - which `expandTags` then includes so they aren't removed
+func preserveIgnoredTagsForResource(r *schema.Resource) {
+	if r.Update == nil || r.Schema["tags"] == nil {
+		return
+	}
+
+	update := r.Update
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*ArmClient)
+
+		// resources in the data plane (e.g. Key Vault Secrets) don't have an Azure Resource Manager ID
+		if client.ignoredTags.IsEmpty() || !strings.HasPrefix(strings.ToLower(d.Id()), "/subscriptions/") {
+			return update(d, meta)
+		}
+
+		ctx, cancel := timeouts.ForUpdate(client.StopContext, d)
+		defer cancel()
+
+		existing, err := client.retrieveTags(ctx, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error retrieving the existing tags for %q to preserve the ignored tags: %+v", d.Id(), err)
+		}
+
+		ignoredTags := make(map[string]*string)
+		for k, v := range existing {
+			if client.ignoredTags.IsIgnored(k) {
+				log.Printf("[DEBUG] Preserving the ignored tag %q on %q", k, d.Id())
+				ignoredTags[k] = v
+			}
+		}
+
+		withIgnoredTags := *client
+		withIgnoredTags.existingIgnoredTags = ignoredTags
+		return update(d, &withIgnoredTags)
+	}
+}
+
+// retrieveTags returns the tags currently assigned to a Resource Group or resource, using the latest API version of
+// the Resource Provider which is available to the Subscription
+func (c *ArmClient) retrieveTags(ctx context.Context, resourceId string) (map[string]*string, error) {
+	id, err := parseAzureResourceID(resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.forSubscription(id.SubscriptionID)
+	if id.Provider == "" {
+		group, err := client.resourceGroupsClient.Get(ctx, id.ResourceGroup)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving Resource Group %q: %+v", id.ResourceGroup, err)
+		}
+
+		return group.Tags, nil
+	}
+
+	// e.g. `Microsoft.Sql/servers/example/databases/example` is the Resource Type `servers/databases`
+	segments := strings.Split(strings.Trim(resourceId[strings.LastIndex(strings.ToLower(resourceId), "/providers/")+len("/providers/"):], "/"), "/")
+	if len(segments) < 3 || len(segments)%2 != 1 {
+		return nil, fmt.Errorf("unable to determine the Resource Type from the ID %q", resourceId)
+	}
+	namespace := segments[0]
+	types := make([]string, 0)
+	for i := 1; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+	resourceType := strings.Join(types, "/")
+
+	apiVersion, err := client.retrieveResourceTypeApiVersion(ctx, namespace, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.resourcesClient.BaseURI),
+		autorest.WithPathParameters("/{resourceId}", map[string]interface{}{
+			"resourceId": strings.TrimPrefix(resourceId, "/"),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("Error preparing the request for %q: %+v", resourceId, err)
+	}
+
+	resp, err := autorest.SendWithSender(client.resourcesClient, req)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving %q: %+v", resourceId, err)
+	}
+
+	var result struct {
+		Tags       map[string]*string `json:"tags"`
+		Properties struct {
+			// DNS Record Sets store their tags as `metadata`
+			Metadata map[string]*string `json:"metadata"`
+		} `json:"properties"`
+	}
+	err = autorest.Respond(resp,
+		client.resourcesClient.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving %q: %+v", resourceId, err)
+	}
+
+	if result.Tags == nil {
+		return result.Properties.Metadata, nil
+	}
+
+	return result.Tags, nil
+}
+
+// retrieveResourceTypeApiVersion returns the latest API version for the Resource Type, preferring
+// API versions which aren't in preview
+func (c *ArmClient) retrieveResourceTypeApiVersion(ctx context.Context, namespace, resourceType string) (string, error) {
+	provider, err := c.providersClient.Get(ctx, namespace, "")
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the Resource Provider %q: %+v", namespace, err)
+	}
+
+	if provider.ResourceTypes != nil {
+		for _, v := range *provider.ResourceTypes {
+			if v.ResourceType == nil || !strings.EqualFold(*v.ResourceType, resourceType) || v.APIVersions == nil || len(*v.APIVersions) == 0 {
+				continue
+			}
+
+			// the API versions are returned newest first
+			for _, apiVersion := range *v.APIVersions {
+				if !strings.Contains(strings.ToLower(apiVersion), "preview") {
+					return apiVersion, nil
+				}
+			}
+
+			return (*v.APIVersions)[0], nil
+		}
+	}
+
+	return "", fmt.Errorf("no API versions were found for the Resource Type %q in the Resource Provider %q", resourceType, namespace)
+}
+
+// containsTagKey returns whether the tags contain the specified key, which is case-insensitive
+// since this is how Azure treats tag keys
+func containsTagKey(tagsMap map[string]*string, key string) bool {
+	for k := range tagsMap {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func filterTags(tagsMap map[string]*string, tagNames ...string) map[string]*string {
 	if len(tagNames) == 0 {
 		return tagsMap
@@ -103,14 +276,75 @@ func filterTags(tagsMap map[string]*string, tagNames ...string) map[string]*stri
 	return tagsRet
 }
 
-func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
+func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string, meta interface{}) {
+	client := meta.(*ArmClient)
+	configuredTags, _ := d.Get("tags").(map[string]interface{})
 
 	// If tagsMap is nil, len(tagsMap) will be 0.
 	output := make(map[string]interface{}, len(tagMap))
 
 	for i, v := range tagMap {
+		if client.ignoredTags.IsIgnored(i) {
+			continue
+		}
+
+		// the Provider's default tags are omitted unless they're also defined on the resource, so that these don't show a diff
+		if _, configured := configuredTags[i]; !configured && client.isDefaultTag(i, v) {
+			continue
+		}
+
 		output[i] = *v
 	}
 
 	d.Set("tags", output)
 }
+
+// flattenAndSetTagsForDataSource sets the tags on the data source, including the Provider's default tags
+func flattenAndSetTagsForDataSource(d *schema.ResourceData, tagMap map[string]*string, meta interface{}) {
+	client := meta.(*ArmClient)
+
+	// If tagsMap is nil, len(tagsMap) will be 0.
+	output := make(map[string]interface{}, len(tagMap))
+
+	for i, v := range tagMap {
+		if client.ignoredTags.IsIgnored(i) {
+			continue
+		}
+
+		output[i] = *v
+	}
+
+	d.Set("tags", output)
+}
+
+// isDefaultTag returns whether the tag has the value defined in the Provider's `default_tags` block
+func (c *ArmClient) isDefaultTag(key string, value *string) bool {
+	defaultValue, ok := c.defaultTags[key]
+	return ok && value != nil && *value == defaultValue
+}
+
+func expandProviderDefaultTags(input []interface{}) map[string]string {
+	output := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	v := input[0].(map[string]interface{})
+	for key, value := range expandTagsWithoutDefaults(v["tags"].(map[string]interface{})) {
+		output[key] = *value
+	}
+
+	return output
+}
+
+func expandTagsWithoutDefaults(tagsMap map[string]interface{}) map[string]*string {
+	output := make(map[string]*string, len(tagsMap))
+
+	for i, v := range tagsMap {
+		//Validate should have ignored this error already
+		value, _ := tagValueToString(v)
+		output[i] = &value
+	}
+
+	return output
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -74,7 +77,7 @@ func TestExpandARMTags(t *testing.T) {
 	testData["key2"] = 21
 	testData["key3"] = "value3"
 
-	expanded := expandTags(testData)
+	expanded := expandTags(testData, &ArmClient{})
 
 	if len(expanded) != 3 {
 		t.Fatalf("Expected 3 results in expanded tag map, got %d", len(expanded))
@@ -113,3 +116,138 @@ func TestFilterARMTags(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], *filtered["key2"])
 	}
 }
+
+func TestExpandARMTags_ProviderDefaultTags(t *testing.T) {
+	client := &ArmClient{
+		defaultTags: map[string]string{
+			"cost_center": "1234",
+			"env":         "prod",
+		},
+	}
+
+	expanded := expandTags(map[string]interface{}{
+		"env":   "test",
+		"owner": "networking",
+	}, client)
+
+	expected := map[string]string{
+		"cost_center": "1234",
+		"env":         "test",
+		"owner":       "networking",
+	}
+	if len(expanded) != len(expected) {
+		t.Fatalf("Expected %d results in expanded tag map, got %d", len(expected), len(expanded))
+	}
+	for k, v := range expected {
+		if expanded[k] == nil || *expanded[k] != v {
+			t.Fatalf("Expected %q to be %q but got %v", k, v, expanded[k])
+		}
+	}
+}
+
+func TestFlattenAndSetTags_ProviderDefaultTags(t *testing.T) {
+	client := &ArmClient{
+		defaultTags: map[string]string{
+			"cost_center": "1234",
+			"env":         "prod",
+			"owner":       "platform",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"env": "prod",
+		},
+	})
+
+	// `cost_center` matches the default so is omitted, `env` is defined on the resource
+	// and `owner` has been changed outside of Terraform
+	values := []string{"1234", "prod", "networking"}
+	flattenAndSetTags(d, map[string]*string{
+		"cost_center": &values[0],
+		"env":         &values[1],
+		"owner":       &values[2],
+	}, client)
+
+	actual := d.Get("tags").(map[string]interface{})
+	expected := map[string]interface{}{
+		"env":   "prod",
+		"owner": "networking",
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected the tags to be %+v but got %+v", expected, actual)
+	}
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Fatalf("Expected the tags to be %+v but got %+v", expected, actual)
+		}
+	}
+}
+
+func TestFlattenAndSetTags_ProviderIgnoredTags(t *testing.T) {
+	client := &ArmClient{
+		ignoredTags: azure.IgnoredTags{
+			Keys:     []string{"CreatedBy"},
+			Prefixes: []string{"policy:"},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{})
+
+	values := []string{"someone", "true", "prod"}
+	flattenAndSetTags(d, map[string]*string{
+		"createdby":         &values[0],
+		"policy:compliance": &values[1],
+		"env":               &values[2],
+	}, client)
+
+	actual := d.Get("tags").(map[string]interface{})
+	if len(actual) != 1 || actual["env"] != "prod" {
+		t.Fatalf("Expected only the `env` tag to be set but got %+v", actual)
+	}
+}
+
+func TestExpandARMTags_ProviderIgnoredTags(t *testing.T) {
+	values := []string{"someone", "true"}
+	client := &ArmClient{
+		existingIgnoredTags: map[string]*string{
+			"CreatedBy":         &values[0],
+			"policy:compliance": &values[1],
+		},
+	}
+
+	// the ignored tags assigned to the resource are retained, unless these're also defined on the resource
+	expanded := expandTags(map[string]interface{}{
+		"createdby": "terraform",
+		"env":       "prod",
+	}, client)
+
+	expected := map[string]string{
+		"createdby":         "terraform",
+		"env":               "prod",
+		"policy:compliance": "true",
+	}
+	if len(expanded) != len(expected) {
+		t.Fatalf("Expected the tags to be %+v but got %+v", expected, expanded)
+	}
+	for k, v := range expected {
+		if expanded[k] == nil || *expanded[k] != v {
+			t.Fatalf("Expected %q to be %q but got %v", k, v, expanded[k])
+		}
+	}
+}
+
+func TestContainsTagKey(t *testing.T) {
+	value := "prod"
+	tagsMap := map[string]*string{
+		"Environment": &value,
+	}
+
+	if !containsTagKey(tagsMap, "environment") {
+		t.Fatalf("Expected `environment` to match `Environment`")
+	}
+
+	if containsTagKey(tagsMap, "env") {
+		t.Fatalf("Expected `env` not to match `Environment`")
+	}
+}
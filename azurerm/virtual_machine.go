@@ -0,0 +1,1031 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the schema, expand and flatten functions in this file are shared between the `azurerm_virtual_machine`,
+// `azurerm_linux_virtual_machine` and `azurerm_windows_virtual_machine` resources
+
+func virtualMachineBootDiagnosticsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"storage_uri": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.ResourceIdentityTypeSystemAssigned),
+						string(compute.ResourceIdentityTypeUserAssigned),
+						string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
+					}, false),
+				},
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"identity_ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func virtualMachinePlanSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"publisher": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"product": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineSecretSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"source_vault_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"vault_certificates": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"certificate_url": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"certificate_store": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// virtualMachineOSDiskSchema is the flat `os_disk` block used by the Linux and Windows Virtual Machine resources,
+// where the OS Disk is always a Managed Disk created from the Source Image
+func virtualMachineOSDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"diff_disk_settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"option": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(compute.Local),
+								}, false),
+							},
+						},
+					},
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validateDiskSizeGB,
+				},
+
+				"name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineSourceImageReferenceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"source_image_id"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"offer": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"sku": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineBootDiagnostics(input []interface{}) *compute.DiagnosticsProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	bootDiagnostic := input[0].(map[string]interface{})
+	return &compute.DiagnosticsProfile{
+		BootDiagnostics: &compute.BootDiagnostics{
+			Enabled:    utils.Bool(bootDiagnostic["enabled"].(bool)),
+			StorageURI: utils.String(bootDiagnostic["storage_uri"].(string)),
+		},
+	}
+}
+
+func expandVirtualMachineIdentity(input []interface{}) *compute.VirtualMachineIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	identity := input[0].(map[string]interface{})
+	identityType := compute.ResourceIdentityType(identity["type"].(string))
+
+	identityIds := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
+	for _, id := range identity["identity_ids"].([]interface{}) {
+		identityIds[id.(string)] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
+	}
+
+	vmIdentity := compute.VirtualMachineIdentity{
+		Type: identityType,
+	}
+
+	if vmIdentity.Type == compute.ResourceIdentityTypeUserAssigned || vmIdentity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned {
+		vmIdentity.UserAssignedIdentities = identityIds
+	}
+
+	return &vmIdentity
+}
+
+func expandVirtualMachinePlan(input []interface{}) *compute.Plan {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	planConfig := input[0].(map[string]interface{})
+
+	publisher := planConfig["publisher"].(string)
+	name := planConfig["name"].(string)
+	product := planConfig["product"].(string)
+
+	return &compute.Plan{
+		Publisher: &publisher,
+		Name:      &name,
+		Product:   &product,
+	}
+}
+
+func expandVirtualMachineSecrets(input []interface{}) *[]compute.VaultSecretGroup {
+	secrets := make([]compute.VaultSecretGroup, 0, len(input))
+
+	for _, secretConfig := range input {
+		config := secretConfig.(map[string]interface{})
+		sourceVaultId := config["source_vault_id"].(string)
+
+		vaultSecretGroup := compute.VaultSecretGroup{
+			SourceVault: &compute.SubResource{
+				ID: &sourceVaultId,
+			},
+		}
+
+		if v := config["vault_certificates"]; v != nil {
+			certsConfig := v.([]interface{})
+			certs := make([]compute.VaultCertificate, 0, len(certsConfig))
+			for _, certConfig := range certsConfig {
+				config := certConfig.(map[string]interface{})
+
+				certUrl := config["certificate_url"].(string)
+				cert := compute.VaultCertificate{
+					CertificateURL: &certUrl,
+				}
+				if v := config["certificate_store"].(string); v != "" {
+					cert.CertificateStore = &v
+				}
+
+				certs = append(certs, cert)
+			}
+			vaultSecretGroup.VaultCertificates = &certs
+		}
+
+		secrets = append(secrets, vaultSecretGroup)
+	}
+
+	return &secrets
+}
+
+// expandVirtualMachineNetworkInterfaceIDs returns the Network Interfaces for the Virtual Machine - where the
+// Network Interface with the ID `primaryId` is marked as the Primary
+func expandVirtualMachineNetworkInterfaceIDs(input []interface{}, primaryId string) *[]compute.NetworkInterfaceReference {
+	networkInterfaces := make([]compute.NetworkInterfaceReference, 0, len(input))
+
+	for _, nic := range input {
+		id := nic.(string)
+		primary := id == primaryId
+
+		networkInterfaces = append(networkInterfaces, compute.NetworkInterfaceReference{
+			ID: utils.String(id),
+			NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{
+				Primary: utils.Bool(primary),
+			},
+		})
+	}
+
+	return &networkInterfaces
+}
+
+func expandVirtualMachineOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.OSDisk {
+	raw := input[0].(map[string]interface{})
+
+	disk := compute.OSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.ManagedDiskParameters{
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if v := raw["disk_size_gb"].(int); v != 0 {
+		disk.DiskSizeGB = utils.Int32(int32(v))
+	}
+
+	if v := raw["name"].(string); v != "" {
+		disk.Name = utils.String(v)
+	}
+
+	if settings := raw["diff_disk_settings"].([]interface{}); len(settings) > 0 && settings[0] != nil {
+		setting := settings[0].(map[string]interface{})
+		disk.DiffDiskSettings = &compute.DiffDiskSettings{
+			Option: compute.DiffDiskOptions(setting["option"].(string)),
+		}
+	}
+
+	return &disk
+}
+
+func expandVirtualMachineSourceImageReference(referenceInput []interface{}, imageId string) (*compute.ImageReference, error) {
+	if imageId != "" {
+		return &compute.ImageReference{
+			ID: utils.String(imageId),
+		}, nil
+	}
+
+	if len(referenceInput) == 0 || referenceInput[0] == nil {
+		return nil, fmt.Errorf("Either a `source_image_id` or a `source_image_reference` block must be specified!")
+	}
+
+	raw := referenceInput[0].(map[string]interface{})
+	return &compute.ImageReference{
+		Publisher: utils.String(raw["publisher"].(string)),
+		Offer:     utils.String(raw["offer"].(string)),
+		Sku:       utils.String(raw["sku"].(string)),
+		Version:   utils.String(raw["version"].(string)),
+	}, nil
+}
+
+func expandVirtualMachineAdditionalUnattendContent(input []interface{}) *[]compute.AdditionalUnattendContent {
+	output := make([]compute.AdditionalUnattendContent, 0, len(input))
+
+	for _, v := range input {
+		config := v.(map[string]interface{})
+		pass := config["pass"].(string)
+		component := config["component"].(string)
+		settingName := config["setting_name"].(string)
+		content := config["content"].(string)
+
+		addContent := compute.AdditionalUnattendContent{
+			PassName:      compute.PassNames(pass),
+			ComponentName: compute.ComponentNames(component),
+			SettingName:   compute.SettingNames(settingName),
+		}
+
+		if content != "" {
+			addContent.Content = &content
+		}
+
+		output = append(output, addContent)
+	}
+
+	return &output
+}
+
+func expandVirtualMachineWinRMListeners(input []interface{}) *compute.WinRMConfiguration {
+	listeners := make([]compute.WinRMListener, 0, len(input))
+
+	for _, v := range input {
+		config := v.(map[string]interface{})
+
+		protocol := config["protocol"].(string)
+		listener := compute.WinRMListener{
+			Protocol: compute.ProtocolTypes(protocol),
+		}
+		if v := config["certificate_url"].(string); v != "" {
+			listener.CertificateURL = &v
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	return &compute.WinRMConfiguration{
+		Listeners: &listeners,
+	}
+}
+
+func flattenVirtualMachineAdditionalUnattendContent(input *[]compute.AdditionalUnattendContent) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		content := ""
+		if v.Content != nil {
+			content = *v.Content
+		}
+
+		output = append(output, map[string]interface{}{
+			"pass":         string(v.PassName),
+			"component":    string(v.ComponentName),
+			"setting_name": string(v.SettingName),
+			"content":      content,
+		})
+	}
+
+	return output
+}
+
+func flattenVirtualMachineOSDisk(input *compute.OSDisk, diskInfo *compute.Disk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	diskId := ""
+	storageAccountType := ""
+	if disk := input.ManagedDisk; disk != nil {
+		storageAccountType = string(disk.StorageAccountType)
+		if disk.ID != nil {
+			diskId = *disk.ID
+		}
+	}
+
+	diskSizeGb := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGb = int(*input.DiskSizeGB)
+	}
+
+	// the Disk itself is the source of truth for the size and type, since these can be changed outside of the VM
+	if diskInfo != nil {
+		if diskInfo.Sku != nil {
+			storageAccountType = string(diskInfo.Sku.Name)
+		}
+		if props := diskInfo.DiskProperties; props != nil && props.DiskSizeGB != nil {
+			diskSizeGb = int(*props.DiskSizeGB)
+		}
+	}
+
+	diffDiskSettings := make([]interface{}, 0)
+	if settings := input.DiffDiskSettings; settings != nil && settings.Option != "" {
+		diffDiskSettings = append(diffDiskSettings, map[string]interface{}{
+			"option": string(settings.Option),
+		})
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"diff_disk_settings":        diffDiskSettings,
+			"disk_size_gb":              diskSizeGb,
+			"id":                        diskId,
+			"name":                      name,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		},
+	}
+}
+
+func flattenVirtualMachineSourceImageReference(input *compute.ImageReference) []interface{} {
+	// a Custom Image is specified using the `source_image_id` field instead
+	if input == nil || input.ID != nil {
+		return []interface{}{}
+	}
+
+	publisher := ""
+	if input.Publisher != nil {
+		publisher = *input.Publisher
+	}
+
+	offer := ""
+	if input.Offer != nil {
+		offer = *input.Offer
+	}
+
+	sku := ""
+	if input.Sku != nil {
+		sku = *input.Sku
+	}
+
+	version := ""
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"publisher": publisher,
+			"offer":     offer,
+			"sku":       sku,
+			"version":   version,
+		},
+	}
+}
+
+func flattenVirtualMachineWinRMListeners(input *compute.WinRMConfiguration) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Listeners == nil {
+		return output
+	}
+
+	for _, v := range *input.Listeners {
+		certificateUrl := ""
+		if v.CertificateURL != nil {
+			certificateUrl = *v.CertificateURL
+		}
+
+		output = append(output, map[string]interface{}{
+			"protocol":        string(v.Protocol),
+			"certificate_url": certificateUrl,
+		})
+	}
+
+	return output
+}
+
+// virtualMachineUpdate describes the changes to be made to an existing Virtual Machine
+type virtualMachineUpdate struct {
+	// model contains the changes to the Virtual Machine itself, if any
+	model *compute.VirtualMachineUpdate
+
+	// osDisk contains the changes to the OS Disk (such as the size and type), if any - which can only be made
+	// once the Virtual Machine has been deallocated
+	osDisk *compute.DiskUpdate
+
+	// deallocate specifies whether the Virtual Machine needs to be deallocated before it can be updated
+	deallocate bool
+}
+
+// virtualMachineSizeRequiresDeallocation returns whether the Virtual Machine has to be deallocated to be resized
+// to the specified size - which is the case when the size isn't available on the hardware cluster it's running on
+func virtualMachineSizeRequiresDeallocation(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup, name, size string) (bool, error) {
+	sizes, err := client.ListAvailableSizes(ctx, resourceGroup, name)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving the available sizes for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if sizes.Value != nil {
+		for _, v := range *sizes.Value {
+			if v.Name != nil && strings.EqualFold(*v.Name, size) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// applyVirtualMachineUpdate applies the changes to an existing Virtual Machine, deallocating the Virtual Machine
+// where required - a Virtual Machine which was running is started again afterwards, even if the update fails
+func applyVirtualMachineUpdate(ctx context.Context, meta interface{}, resourceGroup, name string, osDiskId string, update virtualMachineUpdate) (err error) {
+	client := meta.(*ArmClient).vmClient
+	disksClient := meta.(*ArmClient).diskClient
+
+	if update.deallocate {
+		powerState, err := retrieveVirtualMachinePowerState(ctx, client, resourceGroup, name)
+		if err != nil {
+			return err
+		}
+
+		if powerState != "deallocated" {
+			if err := deallocateVirtualMachine(ctx, client, resourceGroup, name); err != nil {
+				return err
+			}
+		}
+
+		// a Virtual Machine which was Stopped (or already deallocated) is left deallocated, since it wasn't running
+		if powerState == "running" || powerState == "starting" {
+			defer func() {
+				if startErr := startVirtualMachine(ctx, client, resourceGroup, name); startErr != nil {
+					err = multierror.Append(err, startErr)
+				}
+			}()
+		}
+	}
+
+	if update.osDisk != nil {
+		id, err := parseAzureResourceID(osDiskId)
+		if err != nil {
+			return err
+		}
+		diskName := id.Path["disks"]
+
+		log.Printf("[DEBUG] Updating OS Disk %q for Virtual Machine %q (Resource Group %q)..", diskName, name, resourceGroup)
+		future, err := disksClient.Update(ctx, id.ResourceGroup, diskName, *update.osDisk)
+		if err != nil {
+			return fmt.Errorf("Error updating OS Disk %q for Virtual Machine %q (Resource Group %q): %+v", diskName, name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, disksClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for the update of OS Disk %q for Virtual Machine %q (Resource Group %q): %+v", diskName, name, resourceGroup, err)
+		}
+		log.Printf("[DEBUG] Updated OS Disk %q for Virtual Machine %q (Resource Group %q).", diskName, name, resourceGroup)
+	}
+
+	if update.model != nil {
+		log.Printf("[DEBUG] Updating Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+		future, err := client.Update(ctx, resourceGroup, name, *update.model)
+		if err != nil {
+			return fmt.Errorf("Error updating Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the update of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		log.Printf("[DEBUG] Updated Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+	}
+
+	return nil
+}
+
+// deallocateVirtualMachine deallocates the specified Virtual Machine, waiting for this to complete
+func deallocateVirtualMachine(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup, name string) error {
+	log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Deallocate(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deallocation of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Deallocated Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+	return nil
+}
+
+// startVirtualMachine starts the specified Virtual Machine, waiting for this to complete
+func startVirtualMachine(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup, name string) error {
+	log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Start(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Started Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+	return nil
+}
+
+// retrieveVirtualMachinePowerState returns the Power State of the specified Virtual Machine (e.g. `running`
+// or `deallocated`) - or an empty string if the Power State isn't known
+func retrieveVirtualMachinePowerState(ctx context.Context, client compute.VirtualMachinesClient, resourceGroup, name string) (string, error) {
+	instanceView, err := client.InstanceView(ctx, resourceGroup, name)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the Instance View for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return flattenVirtualMachinePowerState(instanceView.Statuses), nil
+}
+
+// flattenVirtualMachinePowerState returns the Power State from the Instance View Statuses, which are in the
+// format `PowerState/running`
+func flattenVirtualMachinePowerState(input *[]compute.InstanceViewStatus) string {
+	if input == nil {
+		return ""
+	}
+
+	for _, status := range *input {
+		if status.Code == nil {
+			continue
+		}
+
+		segments := strings.SplitN(*status.Code, "/", 2)
+		if len(segments) == 2 && strings.EqualFold(segments[0], "PowerState") {
+			return strings.ToLower(segments[1])
+		}
+	}
+
+	return ""
+}
+
+// expandVirtualMachineUpdate determines the changes to be made to an existing Linux or Windows Virtual Machine
+func expandVirtualMachineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, osType compute.OperatingSystemTypes, existing compute.VirtualMachine) (*virtualMachineUpdate, error) {
+	client := meta.(*ArmClient).vmClient
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+
+	update := virtualMachineUpdate{}
+	props := compute.VirtualMachineProperties{}
+	shouldUpdateModel := false
+
+	if d.HasChange("size") {
+		size := d.Get("size").(string)
+		requiresDeallocation, err := virtualMachineSizeRequiresDeallocation(ctx, client, resourceGroup, name, size)
+		if err != nil {
+			return nil, err
+		}
+
+		if requiresDeallocation {
+			log.Printf("[DEBUG] The size %q isn't available on the current hardware cluster - Virtual Machine %q (Resource Group %q) needs to be deallocated to be resized", size, name, resourceGroup)
+			update.deallocate = true
+		}
+
+		props.HardwareProfile = &compute.HardwareProfile{
+			VMSize: compute.VirtualMachineSizeTypes(size),
+		}
+		shouldUpdateModel = true
+	}
+
+	if d.HasChange("os_disk.0.disk_size_gb") || d.HasChange("os_disk.0.storage_account_type") {
+		// an Ephemeral OS Disk lives on the host and is lost when the Virtual Machine is deallocated
+		if settings := d.Get("os_disk.0.diff_disk_settings").([]interface{}); len(settings) > 0 {
+			return nil, fmt.Errorf("The `disk_size_gb` and `storage_account_type` of an Ephemeral OS Disk (where `diff_disk_settings` is specified) cannot be changed for Virtual Machine %q (Resource Group %q) - the Virtual Machine must be recreated instead", name, resourceGroup)
+		}
+
+		diskUpdate := compute.DiskUpdate{}
+
+		if d.HasChange("os_disk.0.disk_size_gb") {
+			oldSize, newSize := d.GetChange("os_disk.0.disk_size_gb")
+			if newSize.(int) < oldSize.(int) {
+				return nil, fmt.Errorf("The size of the OS Disk for Virtual Machine %q (Resource Group %q) can only be increased - (from %d GB to %d GB)", name, resourceGroup, oldSize.(int), newSize.(int))
+			}
+
+			diskUpdate.DiskUpdateProperties = &compute.DiskUpdateProperties{
+				DiskSizeGB: utils.Int32(int32(newSize.(int))),
+			}
+		}
+
+		if d.HasChange("os_disk.0.storage_account_type") {
+			diskUpdate.Sku = &compute.DiskSku{
+				Name: compute.DiskStorageAccountTypes(d.Get("os_disk.0.storage_account_type").(string)),
+			}
+		}
+
+		update.osDisk = &diskUpdate
+		update.deallocate = true
+	}
+
+	if d.HasChange("os_disk.0.caching") || d.HasChange("os_disk.0.write_accelerator_enabled") {
+		if existing.VirtualMachineProperties == nil || existing.VirtualMachineProperties.StorageProfile == nil || existing.VirtualMachineProperties.StorageProfile.OsDisk == nil {
+			return nil, fmt.Errorf("Error updating Virtual Machine %q (Resource Group %q): `storage_profile.os_disk` was nil", name, resourceGroup)
+		}
+
+		osDisk := existing.VirtualMachineProperties.StorageProfile.OsDisk
+		osDisk.Caching = compute.CachingTypes(d.Get("os_disk.0.caching").(string))
+		osDisk.WriteAcceleratorEnabled = utils.Bool(d.Get("os_disk.0.write_accelerator_enabled").(bool))
+
+		props.StorageProfile = &compute.StorageProfile{
+			OsDisk: osDisk,
+		}
+		shouldUpdateModel = true
+	}
+
+	if d.HasChange("boot_diagnostics") {
+		diagnosticsProfile := expandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{}))
+		if diagnosticsProfile == nil {
+			diagnosticsProfile = &compute.DiagnosticsProfile{
+				BootDiagnostics: &compute.BootDiagnostics{
+					Enabled: utils.Bool(false),
+				},
+			}
+		}
+
+		props.DiagnosticsProfile = diagnosticsProfile
+		shouldUpdateModel = true
+	}
+
+	if d.HasChange("secret") || d.HasChange("allow_extension_operations") {
+		props.OsProfile = &compute.OSProfile{
+			AllowExtensionOperations: utils.Bool(d.Get("allow_extension_operations").(bool)),
+			Secrets:                  expandVirtualMachineSecrets(d.Get("secret").([]interface{})),
+		}
+		shouldUpdateModel = true
+	}
+
+	// `license_type` is only available for Windows Virtual Machines
+	if osType == compute.Windows && d.HasChange("license_type") {
+		props.LicenseType = utils.String(d.Get("license_type").(string))
+		shouldUpdateModel = true
+	}
+
+	model := compute.VirtualMachineUpdate{
+		VirtualMachineProperties: &props,
+	}
+
+	if d.HasChange("identity") {
+		identity := expandVirtualMachineIdentity(d.Get("identity").([]interface{}))
+		if identity == nil {
+			identity = &compute.VirtualMachineIdentity{
+				Type: compute.ResourceIdentityTypeNone,
+			}
+		}
+
+		model.Identity = identity
+		shouldUpdateModel = true
+	}
+
+	if d.HasChange("tags") {
+		model.Tags = expandTags(d.Get("tags").(map[string]interface{}), meta)
+		shouldUpdateModel = true
+	}
+
+	if shouldUpdateModel {
+		update.model = &model
+	}
+
+	return &update, nil
+}
+
+func virtualMachineSSHKeysSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"public_key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"username": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+// expandVirtualMachineSSHKeys returns the SSH Public Keys for the Virtual Machine, which are placed in the
+// `authorized_keys` file within the home directory of the specified user
+func expandVirtualMachineSSHKeys(input []interface{}) []compute.SSHPublicKey {
+	output := make([]compute.SSHPublicKey, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		username := raw["username"].(string)
+		output = append(output, compute.SSHPublicKey{
+			KeyData: utils.String(raw["public_key"].(string)),
+			Path:    utils.String(fmt.Sprintf("/home/%s/.ssh/authorized_keys", username)),
+		})
+	}
+
+	return output
+}
+
+func flattenVirtualMachineSSHKeys(input *compute.SSHConfiguration) ([]interface{}, error) {
+	if input == nil || input.PublicKeys == nil {
+		return []interface{}{}, nil
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input.PublicKeys {
+		if v.KeyData == nil || v.Path == nil {
+			continue
+		}
+
+		username, err := parseVirtualMachineUsernameFromAuthorizedKeysPath(*v.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, map[string]interface{}{
+			"public_key": *v.KeyData,
+			"username":   username,
+		})
+	}
+
+	return output, nil
+}
+
+func parseVirtualMachineUsernameFromAuthorizedKeysPath(input string) (string, error) {
+	// the path is in the format `/home/{username}/.ssh/authorized_keys`
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 4 || segments[0] != "home" || segments[2] != ".ssh" || segments[3] != "authorized_keys" {
+		return "", fmt.Errorf("Expected the path of the SSH Key to be in the format `/home/{username}/.ssh/authorized_keys` but got %q", input)
+	}
+
+	return segments[1], nil
+}
+
+// deleteVirtualMachineAndOSDisk deletes the Virtual Machine and then the OS Disk which was created alongside it
+func deleteVirtualMachineAndOSDisk(ctx context.Context, meta interface{}, resourceGroup, name string) error {
+	client := meta.(*ArmClient).vmClient
+	disksClient := meta.(*ArmClient).diskClient
+
+	existing, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine: %+v", err)
+	}
+
+	osDiskId := ""
+	if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil {
+		if disk := props.StorageProfile.OsDisk; disk != nil && disk.ManagedDisk != nil && disk.ManagedDisk.ID != nil {
+			osDiskId = *disk.ManagedDisk.ID
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Virtual Machine: %+v", err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deletion of Virtual Machine: %+v", err)
+	}
+	log.Printf("[DEBUG] Deleted Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+	if osDiskId == "" {
+		return nil
+	}
+
+	id, err := parseAzureResourceID(osDiskId)
+	if err != nil {
+		return err
+	}
+	diskName := id.Path["disks"]
+
+	// Ephemeral OS Disks are removed alongside the Virtual Machine
+	disk, err := disksClient.Get(ctx, id.ResourceGroup, diskName)
+	if err != nil {
+		if utils.ResponseWasNotFound(disk.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving OS Disk %q (Resource Group %q): %+v", diskName, id.ResourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Deleting OS Disk %q (Resource Group %q)..", diskName, id.ResourceGroup)
+	if err := resourceArmVirtualMachineDeleteManagedDisk(ctx, osDiskId, meta); err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Deleted OS Disk %q (Resource Group %q).", diskName, id.ResourceGroup)
+
+	return nil
+}
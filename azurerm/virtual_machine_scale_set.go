@@ -0,0 +1,1039 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// virtualMachineScaleSetResourceName is the name used to lock a Virtual Machine Scale Set, since the model of the
+// Scale Set is updated both by the Scale Set resources and the `azurerm_virtual_machine_scale_set_extension` resource
+var virtualMachineScaleSetResourceName = "azurerm_virtual_machine_scale_set"
+
+// virtualMachineScaleSetRollingUpgradePolicySchema returns the schema for the `rolling_upgrade_policy` block, where
+// `upgradeModeKey` is the field containing the Upgrade Mode - since this block is only returned in Rolling mode
+func virtualMachineScaleSetRollingUpgradePolicySchema(upgradeModeKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_batch_instance_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      20,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"max_unhealthy_instance_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      20,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"max_unhealthy_upgraded_instance_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      20,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"pause_time_between_batches": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "PT0S",
+					ValidateFunc: validateIso8601Duration(),
+				},
+			},
+		},
+		// When the Upgrade Mode is not Rolling, we will just ignore the `rolling_upgrade_policy`
+		DiffSuppressFunc: func(k, _, new string, d *schema.ResourceData) bool {
+			if k == "rolling_upgrade_policy.#" && new == "0" {
+				return !strings.EqualFold(d.Get(upgradeModeKey).(string), string(compute.Rolling))
+			}
+			return false
+		},
+	}
+}
+
+func virtualMachineScaleSetInstanceUpdatePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_batch_instance_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      100,
+					ValidateFunc: validation.IntBetween(5, 100),
+				},
+
+				"max_failed_instance_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineScaleSetNetworkInterfaceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"ip_configuration": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+
+							"application_gateway_backend_address_pool_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: resourceid.ValidateApplicationGatewayBackendAddressPoolId,
+								},
+								Set: schema.HashString,
+							},
+
+							"application_security_group_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: resourceid.ValidateApplicationSecurityGroupId,
+								},
+								Set:      schema.HashString,
+								MaxItems: 20,
+							},
+
+							"load_balancer_backend_address_pool_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: resourceid.ValidateLoadBalancerBackendAddressPoolId,
+								},
+								Set: schema.HashString,
+							},
+
+							"load_balancer_inbound_nat_rules_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: resourceid.ValidateLoadBalancerInboundNatPoolId,
+								},
+								Set: schema.HashString,
+							},
+
+							"primary": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"public_ip_address": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+
+										"domain_name_label": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+
+										"idle_timeout_in_minutes": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Computed:     true,
+											ValidateFunc: validation.IntBetween(4, 32),
+										},
+									},
+								},
+							},
+
+							"subnet_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: resourceid.ValidateSubnetId,
+							},
+
+							"version": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  string(compute.IPv4),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(compute.IPv4),
+									string(compute.IPv6),
+								}, false),
+							},
+						},
+					},
+				},
+
+				"dns_servers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"enable_accelerated_networking": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"enable_ip_forwarding": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"network_security_group_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: resourceid.ValidateNetworkSecurityGroupId,
+				},
+
+				"primary": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineScaleSetOSDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					// whilst this appears in the Update block the API returns this when changing:
+					// Changing property 'osDisk.managedDisk.storageAccountType' is not allowed
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"diff_disk_settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"option": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(compute.Local),
+								}, false),
+							},
+						},
+					},
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 1023),
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func virtualMachineScaleSetDataDiskSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 4095),
+				},
+
+				"lun": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(0, 63),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetRollingUpgradePolicy(input []interface{}) *compute.RollingUpgradePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	policy := input[0].(map[string]interface{})
+	return &compute.RollingUpgradePolicy{
+		MaxBatchInstancePercent:             utils.Int32(int32(policy["max_batch_instance_percent"].(int))),
+		MaxUnhealthyInstancePercent:         utils.Int32(int32(policy["max_unhealthy_instance_percent"].(int))),
+		MaxUnhealthyUpgradedInstancePercent: utils.Int32(int32(policy["max_unhealthy_upgraded_instance_percent"].(int))),
+		PauseTimeBetweenBatches:             utils.String(policy["pause_time_between_batches"].(string)),
+	}
+}
+
+// expandVirtualMachineScaleSetUpgradePolicy expands the `upgrade_mode`, `automatic_os_upgrade` and
+// `rolling_upgrade_policy` fields into an Upgrade Policy
+func expandVirtualMachineScaleSetUpgradePolicy(d *schema.ResourceData) (*compute.UpgradePolicy, error) {
+	upgradeMode := compute.UpgradeMode(d.Get("upgrade_mode").(string))
+	rollingUpgradePolicy := expandVirtualMachineScaleSetRollingUpgradePolicy(d.Get("rolling_upgrade_policy").([]interface{}))
+
+	if upgradeMode == compute.Rolling && rollingUpgradePolicy == nil {
+		return nil, fmt.Errorf("A `rolling_upgrade_policy` block must be specified when `upgrade_mode` is set to %q", string(compute.Rolling))
+	}
+	if upgradeMode != compute.Rolling && rollingUpgradePolicy != nil {
+		return nil, fmt.Errorf("A `rolling_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
+	}
+
+	return &compute.UpgradePolicy{
+		Mode:                 upgradeMode,
+		AutomaticOSUpgrade:   utils.Bool(d.Get("automatic_os_upgrade").(bool)),
+		RollingUpgradePolicy: rollingUpgradePolicy,
+	}, nil
+}
+
+func expandVirtualMachineScaleSetIdentity(input []interface{}) *compute.VirtualMachineScaleSetIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	identity := input[0].(map[string]interface{})
+	identityType := compute.ResourceIdentityType(identity["type"].(string))
+
+	identityIds := make(map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue)
+	for _, id := range identity["identity_ids"].([]interface{}) {
+		identityIds[id.(string)] = &compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{}
+	}
+
+	vmssIdentity := compute.VirtualMachineScaleSetIdentity{
+		Type: identityType,
+	}
+
+	if vmssIdentity.Type == compute.ResourceIdentityTypeUserAssigned || vmssIdentity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned {
+		vmssIdentity.UserAssignedIdentities = identityIds
+	}
+
+	return &vmssIdentity
+}
+
+func expandVirtualMachineScaleSetNetworkInterfaces(input []interface{}, healthProbeId string) *compute.VirtualMachineScaleSetNetworkProfile {
+	networkConfigurations := make([]compute.VirtualMachineScaleSetNetworkConfiguration, 0, len(input))
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		ipConfigurations := make([]compute.VirtualMachineScaleSetIPConfiguration, 0)
+		for _, ipConfigRaw := range raw["ip_configuration"].([]interface{}) {
+			ipConfigurations = append(ipConfigurations, expandVirtualMachineScaleSetIPConfiguration(ipConfigRaw.(map[string]interface{})))
+		}
+
+		dnsServers := utils.ExpandStringArray(raw["dns_servers"].([]interface{}))
+
+		config := compute.VirtualMachineScaleSetNetworkConfiguration{
+			Name: utils.String(raw["name"].(string)),
+			VirtualMachineScaleSetNetworkConfigurationProperties: &compute.VirtualMachineScaleSetNetworkConfigurationProperties{
+				DNSSettings: &compute.VirtualMachineScaleSetNetworkConfigurationDNSSettings{
+					DNSServers: dnsServers,
+				},
+				EnableAcceleratedNetworking: utils.Bool(raw["enable_accelerated_networking"].(bool)),
+				EnableIPForwarding:          utils.Bool(raw["enable_ip_forwarding"].(bool)),
+				IPConfigurations:            &ipConfigurations,
+				Primary:                     utils.Bool(raw["primary"].(bool)),
+			},
+		}
+
+		if nsgId := raw["network_security_group_id"].(string); nsgId != "" {
+			config.VirtualMachineScaleSetNetworkConfigurationProperties.NetworkSecurityGroup = &compute.SubResource{
+				ID: utils.String(nsgId),
+			}
+		}
+
+		networkConfigurations = append(networkConfigurations, config)
+	}
+
+	profile := compute.VirtualMachineScaleSetNetworkProfile{
+		NetworkInterfaceConfigurations: &networkConfigurations,
+	}
+
+	if healthProbeId != "" {
+		profile.HealthProbe = &compute.APIEntityReference{
+			ID: utils.String(healthProbeId),
+		}
+	}
+
+	return &profile
+}
+
+func expandVirtualMachineScaleSetIPConfiguration(raw map[string]interface{}) compute.VirtualMachineScaleSetIPConfiguration {
+	applicationGatewayBackendAddressPoolIds := expandVirtualMachineScaleSetSubResources(raw["application_gateway_backend_address_pool_ids"].(*schema.Set).List())
+	applicationSecurityGroupIds := expandVirtualMachineScaleSetSubResources(raw["application_security_group_ids"].(*schema.Set).List())
+	loadBalancerBackendAddressPoolIds := expandVirtualMachineScaleSetSubResources(raw["load_balancer_backend_address_pool_ids"].(*schema.Set).List())
+	loadBalancerInboundNatPoolIds := expandVirtualMachineScaleSetSubResources(raw["load_balancer_inbound_nat_rules_ids"].(*schema.Set).List())
+
+	ipConfiguration := compute.VirtualMachineScaleSetIPConfiguration{
+		Name: utils.String(raw["name"].(string)),
+		VirtualMachineScaleSetIPConfigurationProperties: &compute.VirtualMachineScaleSetIPConfigurationProperties{
+			Primary:                               utils.Bool(raw["primary"].(bool)),
+			PrivateIPAddressVersion:               compute.IPVersion(raw["version"].(string)),
+			ApplicationGatewayBackendAddressPools: applicationGatewayBackendAddressPoolIds,
+			ApplicationSecurityGroups:             applicationSecurityGroupIds,
+			LoadBalancerBackendAddressPools:       loadBalancerBackendAddressPoolIds,
+			LoadBalancerInboundNatPools:           loadBalancerInboundNatPoolIds,
+		},
+	}
+
+	if subnetId := raw["subnet_id"].(string); subnetId != "" {
+		ipConfiguration.VirtualMachineScaleSetIPConfigurationProperties.Subnet = &compute.APIEntityReference{
+			ID: utils.String(subnetId),
+		}
+	}
+
+	if publicIPs := raw["public_ip_address"].([]interface{}); len(publicIPs) > 0 && publicIPs[0] != nil {
+		publicIP := publicIPs[0].(map[string]interface{})
+
+		config := compute.VirtualMachineScaleSetPublicIPAddressConfiguration{
+			Name: utils.String(publicIP["name"].(string)),
+			VirtualMachineScaleSetPublicIPAddressConfigurationProperties: &compute.VirtualMachineScaleSetPublicIPAddressConfigurationProperties{},
+		}
+
+		if v := publicIP["domain_name_label"].(string); v != "" {
+			config.VirtualMachineScaleSetPublicIPAddressConfigurationProperties.DNSSettings = &compute.VirtualMachineScaleSetPublicIPAddressConfigurationDNSSettings{
+				DomainNameLabel: utils.String(v),
+			}
+		}
+
+		if v := publicIP["idle_timeout_in_minutes"].(int); v > 0 {
+			config.VirtualMachineScaleSetPublicIPAddressConfigurationProperties.IdleTimeoutInMinutes = utils.Int32(int32(v))
+		}
+
+		ipConfiguration.VirtualMachineScaleSetIPConfigurationProperties.PublicIPAddressConfiguration = &config
+	}
+
+	return ipConfiguration
+}
+
+func expandVirtualMachineScaleSetSubResources(input []interface{}) *[]compute.SubResource {
+	resources := make([]compute.SubResource, 0, len(input))
+	for _, v := range input {
+		resources = append(resources, compute.SubResource{
+			ID: utils.String(v.(string)),
+		})
+	}
+	return &resources
+}
+
+func expandVirtualMachineScaleSetOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.VirtualMachineScaleSetOSDisk {
+	raw := input[0].(map[string]interface{})
+
+	disk := compute.VirtualMachineScaleSetOSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if v := raw["disk_size_gb"].(int); v != 0 {
+		disk.DiskSizeGB = utils.Int32(int32(v))
+	}
+
+	if settings := raw["diff_disk_settings"].([]interface{}); len(settings) > 0 && settings[0] != nil {
+		setting := settings[0].(map[string]interface{})
+		disk.DiffDiskSettings = &compute.DiffDiskSettings{
+			Option: compute.DiffDiskOptions(setting["option"].(string)),
+		}
+	}
+
+	return &disk
+}
+
+func expandVirtualMachineScaleSetDataDisks(input []interface{}) *[]compute.VirtualMachineScaleSetDataDisk {
+	disks := make([]compute.VirtualMachineScaleSetDataDisk, 0, len(input))
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		disks = append(disks, compute.VirtualMachineScaleSetDataDisk{
+			Caching:    compute.CachingTypes(raw["caching"].(string)),
+			DiskSizeGB: utils.Int32(int32(raw["disk_size_gb"].(int))),
+			Lun:        utils.Int32(int32(raw["lun"].(int))),
+			ManagedDisk: &compute.VirtualMachineScaleSetManagedDiskParameters{
+				StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+			},
+			WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+			// AFAIK this is required to be Empty
+			CreateOption: compute.DiskCreateOptionTypesEmpty,
+		})
+	}
+
+	return &disks
+}
+
+func flattenVirtualMachineScaleSetNetworkInterfaces(input *compute.VirtualMachineScaleSetNetworkProfile) []interface{} {
+	if input == nil || input.NetworkInterfaceConfigurations == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, v := range *input.NetworkInterfaceConfigurations {
+		var name, networkSecurityGroupId string
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		var enableAcceleratedNetworking, enableIPForwarding, primary bool
+		dnsServers := make([]interface{}, 0)
+		ipConfigurations := make([]interface{}, 0)
+
+		if props := v.VirtualMachineScaleSetNetworkConfigurationProperties; props != nil {
+			if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
+				networkSecurityGroupId = *props.NetworkSecurityGroup.ID
+			}
+			if props.EnableAcceleratedNetworking != nil {
+				enableAcceleratedNetworking = *props.EnableAcceleratedNetworking
+			}
+			if props.EnableIPForwarding != nil {
+				enableIPForwarding = *props.EnableIPForwarding
+			}
+			if props.Primary != nil {
+				primary = *props.Primary
+			}
+
+			if settings := props.DNSSettings; settings != nil && settings.DNSServers != nil {
+				dnsServers = utils.FlattenStringArray(settings.DNSServers)
+			}
+
+			if props.IPConfigurations != nil {
+				for _, ipConfig := range *props.IPConfigurations {
+					ipConfigurations = append(ipConfigurations, flattenVirtualMachineScaleSetIPConfiguration(ipConfig))
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          name,
+			"dns_servers":                   dnsServers,
+			"enable_accelerated_networking": enableAcceleratedNetworking,
+			"enable_ip_forwarding":          enableIPForwarding,
+			"ip_configuration":              ipConfigurations,
+			"network_security_group_id":     networkSecurityGroupId,
+			"primary":                       primary,
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualMachineScaleSetIPConfiguration(input compute.VirtualMachineScaleSetIPConfiguration) map[string]interface{} {
+	var name, subnetId string
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	primary := false
+	version := string(compute.IPv4)
+	var applicationGatewayBackendAddressPoolIds, applicationSecurityGroupIds, loadBalancerBackendAddressPoolIds, loadBalancerInboundNatPoolIds []interface{}
+	publicIPAddresses := make([]interface{}, 0)
+
+	if props := input.VirtualMachineScaleSetIPConfigurationProperties; props != nil {
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			subnetId = *props.Subnet.ID
+		}
+		if props.Primary != nil {
+			primary = *props.Primary
+		}
+		if props.PrivateIPAddressVersion != "" {
+			version = string(props.PrivateIPAddressVersion)
+		}
+
+		applicationGatewayBackendAddressPoolIds = flattenVirtualMachineScaleSetSubResources(props.ApplicationGatewayBackendAddressPools)
+		applicationSecurityGroupIds = flattenVirtualMachineScaleSetSubResources(props.ApplicationSecurityGroups)
+		loadBalancerBackendAddressPoolIds = flattenVirtualMachineScaleSetSubResources(props.LoadBalancerBackendAddressPools)
+		loadBalancerInboundNatPoolIds = flattenVirtualMachineScaleSetSubResources(props.LoadBalancerInboundNatPools)
+
+		if publicIP := props.PublicIPAddressConfiguration; publicIP != nil {
+			var publicIPName, domainNameLabel string
+			var idleTimeoutInMinutes int
+			if publicIP.Name != nil {
+				publicIPName = *publicIP.Name
+			}
+
+			if publicIPProps := publicIP.VirtualMachineScaleSetPublicIPAddressConfigurationProperties; publicIPProps != nil {
+				if publicIPProps.DNSSettings != nil && publicIPProps.DNSSettings.DomainNameLabel != nil {
+					domainNameLabel = *publicIPProps.DNSSettings.DomainNameLabel
+				}
+				if publicIPProps.IdleTimeoutInMinutes != nil {
+					idleTimeoutInMinutes = int(*publicIPProps.IdleTimeoutInMinutes)
+				}
+			}
+
+			publicIPAddresses = append(publicIPAddresses, map[string]interface{}{
+				"name":                    publicIPName,
+				"domain_name_label":       domainNameLabel,
+				"idle_timeout_in_minutes": idleTimeoutInMinutes,
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"name":    name,
+		"primary": primary,
+		"application_gateway_backend_address_pool_ids": schema.NewSet(schema.HashString, applicationGatewayBackendAddressPoolIds),
+		"application_security_group_ids":               schema.NewSet(schema.HashString, applicationSecurityGroupIds),
+		"load_balancer_backend_address_pool_ids":       schema.NewSet(schema.HashString, loadBalancerBackendAddressPoolIds),
+		"load_balancer_inbound_nat_rules_ids":          schema.NewSet(schema.HashString, loadBalancerInboundNatPoolIds),
+		"public_ip_address":                            publicIPAddresses,
+		"subnet_id":                                    subnetId,
+		"version":                                      version,
+	}
+}
+
+func flattenVirtualMachineScaleSetSubResources(input *[]compute.SubResource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.ID != nil {
+			results = append(results, *v.ID)
+		}
+	}
+
+	return results
+}
+
+func flattenVirtualMachineScaleSetOSDisk(input *compute.VirtualMachineScaleSetOSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	diffDiskSettings := make([]interface{}, 0)
+	if input.DiffDiskSettings != nil && input.DiffDiskSettings.Option != "" {
+		diffDiskSettings = append(diffDiskSettings, map[string]interface{}{
+			"option": string(input.DiffDiskSettings.Option),
+		})
+	}
+
+	diskSizeGb := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGb = int(*input.DiskSizeGB)
+	}
+
+	storageAccountType := ""
+	if input.ManagedDisk != nil {
+		storageAccountType = string(input.ManagedDisk.StorageAccountType)
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"diff_disk_settings":        diffDiskSettings,
+			"disk_size_gb":              diskSizeGb,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		},
+	}
+}
+
+func flattenVirtualMachineScaleSetDataDisks(input *[]compute.VirtualMachineScaleSetDataDisk) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		diskSizeGb := 0
+		if v.DiskSizeGB != nil {
+			diskSizeGb = int(*v.DiskSizeGB)
+		}
+
+		lun := 0
+		if v.Lun != nil {
+			lun = int(*v.Lun)
+		}
+
+		storageAccountType := ""
+		if v.ManagedDisk != nil {
+			storageAccountType = string(v.ManagedDisk.StorageAccountType)
+		}
+
+		writeAcceleratorEnabled := false
+		if v.WriteAcceleratorEnabled != nil {
+			writeAcceleratorEnabled = *v.WriteAcceleratorEnabled
+		}
+
+		results = append(results, map[string]interface{}{
+			"caching":                   string(v.Caching),
+			"disk_size_gb":              diskSizeGb,
+			"lun":                       lun,
+			"storage_account_type":      storageAccountType,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		})
+	}
+
+	return results
+}
+
+// virtualMachineScaleSetInstanceUpdatePolicy controls how the latest model of a Scale Set is rolled out
+// to the existing instances once the model has been updated
+type virtualMachineScaleSetInstanceUpdatePolicy struct {
+	// maxBatchInstancePercent is the percentage of instances updated at once in Manual mode
+	maxBatchInstancePercent int
+
+	// maxFailedInstancePercent is the percentage of instances which can fail to update before the update is failed
+	maxFailedInstancePercent int
+}
+
+func expandVirtualMachineScaleSetInstanceUpdatePolicy(input []interface{}) *virtualMachineScaleSetInstanceUpdatePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &virtualMachineScaleSetInstanceUpdatePolicy{
+		maxBatchInstancePercent:  raw["max_batch_instance_percent"].(int),
+		maxFailedInstancePercent: raw["max_failed_instance_percent"].(int),
+	}
+}
+
+// batchVirtualMachineScaleSetInstanceIds splits the Instance ID's into batches containing at most
+// `maxBatchInstancePercent` percent of the `totalInstances` (and at least one instance)
+func batchVirtualMachineScaleSetInstanceIds(instanceIds []string, totalInstances int, maxBatchInstancePercent int) [][]string {
+	batchSize := int(math.Ceil(float64(totalInstances) * float64(maxBatchInstancePercent) / 100))
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	batches := make([][]string, 0)
+	for i := 0; i < len(instanceIds); i += batchSize {
+		end := i + batchSize
+		if end > len(instanceIds) {
+			end = len(instanceIds)
+		}
+		batches = append(batches, instanceIds[i:end])
+	}
+	return batches
+}
+
+// virtualMachineScaleSetFailureThresholdExceeded returns whether `failed` out of `total` instances exceeds the
+// percentage of instances which are allowed to fail
+func virtualMachineScaleSetFailureThresholdExceeded(failed, total, maxFailedInstancePercent int) bool {
+	if failed == 0 || total == 0 {
+		return false
+	}
+
+	return float64(failed)*100/float64(total) > float64(maxFailedInstancePercent)
+}
+
+// rollOutVirtualMachineScaleSetModel ensures the existing instances within the Scale Set are running the latest
+// model - in Manual mode by updating the instances in batches, in Rolling mode by waiting for the Rolling Upgrade
+// started by the platform to complete. Automatic mode updates all instances at once so there's nothing to do.
+func rollOutVirtualMachineScaleSetModel(ctx context.Context, meta interface{}, resourceGroup, name string, upgradeMode compute.UpgradeMode, policy virtualMachineScaleSetInstanceUpdatePolicy, modelUpdatedAt time.Time, timeout time.Duration) error {
+	switch upgradeMode {
+	case compute.Manual:
+		return updateVirtualMachineScaleSetInstances(ctx, meta, resourceGroup, name, policy)
+	case compute.Rolling:
+		return waitForVirtualMachineScaleSetRollingUpgrade(ctx, meta, resourceGroup, name, policy, modelUpdatedAt, timeout)
+	}
+
+	return nil
+}
+
+// listVirtualMachineScaleSetOutdatedInstances returns the total number of instances within the Scale Set,
+// alongside the ID's of the instances which aren't running the latest model
+func listVirtualMachineScaleSetOutdatedInstances(ctx context.Context, client compute.VirtualMachineScaleSetVMsClient, resourceGroup, name string) (int, []string, error) {
+	total := 0
+	outdated := make([]string, 0)
+
+	iterator, err := client.ListComplete(ctx, resourceGroup, name, "", "", "")
+	if err != nil {
+		return 0, nil, fmt.Errorf("Error listing instances: %+v", err)
+	}
+
+	for iterator.NotDone() {
+		instance := iterator.Value()
+		total++
+
+		if instance.InstanceID != nil && instance.VirtualMachineScaleSetVMProperties != nil {
+			if latest := instance.VirtualMachineScaleSetVMProperties.LatestModelApplied; latest != nil && !*latest {
+				outdated = append(outdated, *instance.InstanceID)
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return 0, nil, fmt.Errorf("Error listing instances: %+v", err)
+		}
+	}
+
+	return total, outdated, nil
+}
+
+func updateVirtualMachineScaleSetInstances(ctx context.Context, meta interface{}, resourceGroup, name string, policy virtualMachineScaleSetInstanceUpdatePolicy) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	vmsClient := meta.(*ArmClient).vmScaleSetVMsClient
+
+	total, outdated, err := listVirtualMachineScaleSetOutdatedInstances(ctx, vmsClient, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error determining the outdated instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if len(outdated) == 0 {
+		log.Printf("[DEBUG] All instances within Virtual Machine Scale Set %q (Resource Group %q) are running the latest model", name, resourceGroup)
+		return nil
+	}
+
+	failed := 0
+	var batchErrors *multierror.Error
+	for _, batch := range batchVirtualMachineScaleSetInstanceIds(outdated, total, policy.maxBatchInstancePercent) {
+		log.Printf("[DEBUG] Updating instances %s of Virtual Machine Scale Set %q (Resource Group %q)..", strings.Join(batch, ", "), name, resourceGroup)
+		instanceIds := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: &batch,
+		}
+		future, err := client.UpdateInstances(ctx, resourceGroup, name, instanceIds)
+		if err == nil {
+			err = future.WaitForCompletionRef(ctx, client.Client)
+		}
+		if err != nil {
+			// the batch failing doesn't mean each instance within it has - so we check which were updated below
+			log.Printf("[DEBUG] Error updating instances %s of Virtual Machine Scale Set %q (Resource Group %q): %+v", strings.Join(batch, ", "), name, resourceGroup, err)
+			batchErrors = multierror.Append(batchErrors, fmt.Errorf("Error updating instances %s: %+v", strings.Join(batch, ", "), err))
+		}
+
+		_, stillOutdated, err := listVirtualMachineScaleSetOutdatedInstances(ctx, vmsClient, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error determining the outdated instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		for _, instanceId := range batch {
+			for _, outdatedId := range stillOutdated {
+				if instanceId == outdatedId {
+					failed++
+					break
+				}
+			}
+		}
+
+		if virtualMachineScaleSetFailureThresholdExceeded(failed, total, policy.maxFailedInstancePercent) {
+			err := fmt.Errorf("Error updating Virtual Machine Scale Set %q (Resource Group %q): %d of %d instances failed to update to the latest model, exceeding `max_failed_instance_percent` of %d%%", name, resourceGroup, failed, total, policy.maxFailedInstancePercent)
+			if batchErrors != nil {
+				// include the reasons the batches failed, since otherwise there's no indication why
+				err = fmt.Errorf("%+v: %+v", err, batchErrors)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func waitForVirtualMachineScaleSetRollingUpgrade(ctx context.Context, meta interface{}, resourceGroup, name string, policy virtualMachineScaleSetInstanceUpdatePolicy, modelUpdatedAt time.Time, timeout time.Duration) error {
+	client := meta.(*ArmClient).vmScaleSetRollingUpgradesClient
+	vmsClient := meta.(*ArmClient).vmScaleSetVMsClient
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"NotStarted",
+			string(compute.RollingUpgradeStatusCodeRollingForward),
+		},
+		Target: []string{
+			string(compute.RollingUpgradeStatusCodeCompleted),
+		},
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetLatest(ctx, resourceGroup, name)
+			if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+				return nil, "", fmt.Errorf("Error retrieving the latest Rolling Upgrade: %+v", err)
+			}
+
+			var status *compute.RollingUpgradeRunningStatus
+			if props := resp.RollingUpgradeStatusInfoProperties; props != nil {
+				status = props.RunningStatus
+			}
+
+			if status == nil || status.StartTime == nil || status.StartTime.Before(modelUpdatedAt) {
+				// the platform may not have started the Rolling Upgrade yet - or may not need to, if each instance
+				// is already running the latest model
+				_, outdated, err := listVirtualMachineScaleSetOutdatedInstances(ctx, vmsClient, resourceGroup, name)
+				if err != nil {
+					return nil, "", err
+				}
+
+				if len(outdated) == 0 {
+					return resp, string(compute.RollingUpgradeStatusCodeCompleted), nil
+				}
+
+				return resp, "NotStarted", nil
+			}
+
+			var failed, total int
+			if progress := resp.RollingUpgradeStatusInfoProperties.Progress; progress != nil {
+				for _, count := range []*int32{progress.SuccessfulInstanceCount, progress.FailedInstanceCount, progress.InProgressInstanceCount, progress.PendingInstanceCount} {
+					if count != nil {
+						total += int(*count)
+					}
+				}
+				if progress.FailedInstanceCount != nil {
+					failed = int(*progress.FailedInstanceCount)
+				}
+			}
+
+			if virtualMachineScaleSetFailureThresholdExceeded(failed, total, policy.maxFailedInstancePercent) {
+				return nil, "", fmt.Errorf("%d of %d instances failed to upgrade to the latest model, exceeding `max_failed_instance_percent` of %d%%", failed, total, policy.maxFailedInstancePercent)
+			}
+
+			switch status.Code {
+			case compute.RollingUpgradeStatusCodeCancelled, compute.RollingUpgradeStatusCodeFaulted:
+				message := ""
+				if apiError := resp.RollingUpgradeStatusInfoProperties.Error; apiError != nil && apiError.Message != nil {
+					message = *apiError.Message
+				}
+				return nil, "", fmt.Errorf("the Rolling Upgrade finished with the status %q: %s", string(status.Code), message)
+			}
+
+			return resp, string(status.Code), nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete: %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// retrieveVirtualMachineScaleSetExtensionProfile returns the Extensions currently assigned to the Virtual Machine Scale Set,
+// which are managed by the `azurerm_virtual_machine_scale_set_extension` resource and as such need to be retained when
+// the Scale Set is updated
+func retrieveVirtualMachineScaleSetExtensionProfile(ctx context.Context, client compute.VirtualMachineScaleSetsClient, resourceGroup, name string) (*compute.VirtualMachineScaleSetExtensionProfile, error) {
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if props := existing.VirtualMachineScaleSetProperties; props != nil {
+		if profile := props.VirtualMachineProfile; profile != nil {
+			return profile.ExtensionProfile, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,112 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBatchVirtualMachineScaleSetInstanceIds(t *testing.T) {
+	cases := []struct {
+		InstanceIds             []string
+		TotalInstances          int
+		MaxBatchInstancePercent int
+		Expected                [][]string
+	}{
+		{
+			InstanceIds:             []string{},
+			TotalInstances:          0,
+			MaxBatchInstancePercent: 20,
+			Expected:                [][]string{},
+		},
+		{
+			InstanceIds:             []string{"0", "1", "2"},
+			TotalInstances:          3,
+			MaxBatchInstancePercent: 100,
+			Expected:                [][]string{{"0", "1", "2"}},
+		},
+		{
+			// at least one instance is updated in each batch
+			InstanceIds:             []string{"0", "1", "2"},
+			TotalInstances:          3,
+			MaxBatchInstancePercent: 5,
+			Expected:                [][]string{{"0"}, {"1"}, {"2"}},
+		},
+		{
+			InstanceIds:             []string{"0", "1", "2", "3", "4"},
+			TotalInstances:          5,
+			MaxBatchInstancePercent: 50,
+			Expected:                [][]string{{"0", "1", "2"}, {"3", "4"}},
+		},
+		{
+			// the batch size is based on the total number of instances rather than the outdated instances
+			InstanceIds:             []string{"3", "7"},
+			TotalInstances:          10,
+			MaxBatchInstancePercent: 20,
+			Expected:                [][]string{{"3", "7"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %d instances in batches of %d%%", len(tc.InstanceIds), tc.MaxBatchInstancePercent)
+
+		actual := batchVirtualMachineScaleSetInstanceIds(tc.InstanceIds, tc.TotalInstances, tc.MaxBatchInstancePercent)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetFailureThresholdExceeded(t *testing.T) {
+	cases := []struct {
+		Failed                   int
+		Total                    int
+		MaxFailedInstancePercent int
+		Expected                 bool
+	}{
+		{
+			Failed:                   0,
+			Total:                    0,
+			MaxFailedInstancePercent: 0,
+			Expected:                 false,
+		},
+		{
+			Failed:                   0,
+			Total:                    10,
+			MaxFailedInstancePercent: 0,
+			Expected:                 false,
+		},
+		{
+			Failed:                   1,
+			Total:                    10,
+			MaxFailedInstancePercent: 0,
+			Expected:                 true,
+		},
+		{
+			Failed:                   1,
+			Total:                    10,
+			MaxFailedInstancePercent: 10,
+			Expected:                 false,
+		},
+		{
+			Failed:                   2,
+			Total:                    10,
+			MaxFailedInstancePercent: 10,
+			Expected:                 true,
+		},
+		{
+			Failed:                   3,
+			Total:                    3,
+			MaxFailedInstancePercent: 100,
+			Expected:                 false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %d of %d failed with a threshold of %d%%", tc.Failed, tc.Total, tc.MaxFailedInstancePercent)
+
+		actual := virtualMachineScaleSetFailureThresholdExceeded(tc.Failed, tc.Total, tc.MaxFailedInstancePercent)
+		if actual != tc.Expected {
+			t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseVirtualMachineUsernameFromAuthorizedKeysPath(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input:    "/home/adminuser/.ssh/authorized_keys",
+			Expected: "adminuser",
+		},
+		{
+			Input: "/home/adminuser/.ssh/id_rsa.pub",
+			Error: true,
+		},
+		{
+			Input: "/root/.ssh/authorized_keys",
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		actual, err := parseVirtualMachineUsernameFromAuthorizedKeysPath(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if tc.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestValidateWindowsVirtualMachineComputerName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "hello",
+			Valid: true,
+		},
+		{
+			Input: "hello-world-123",
+			Valid: true,
+		},
+		{
+			Input: "hello-world-1234",
+			Valid: false,
+		},
+		{
+			Input: "12345",
+			Valid: false,
+		},
+		{
+			Input: "_hello",
+			Valid: false,
+		},
+		{
+			Input: "hello-",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		_, errors := validateWindowsVirtualMachineComputerName(tc.Input, "computer_name")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestFlattenVirtualMachinePowerState(t *testing.T) {
+	cases := []struct {
+		Input    *[]compute.InstanceViewStatus
+		Expected string
+	}{
+		{
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Input:    &[]compute.InstanceViewStatus{},
+			Expected: "",
+		},
+		{
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code: utils.String("ProvisioningState/succeeded"),
+				},
+			},
+			Expected: "",
+		},
+		{
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code: utils.String("ProvisioningState/succeeded"),
+				},
+				{
+					Code: utils.String("PowerState/running"),
+				},
+			},
+			Expected: "running",
+		},
+		{
+			Input: &[]compute.InstanceViewStatus{
+				{},
+				{
+					Code: utils.String("powerstate/Deallocated"),
+				},
+			},
+			Expected: "deallocated",
+		},
+	}
+
+	for _, tc := range cases {
+		actual := flattenVirtualMachinePowerState(tc.Input)
+		if actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+#!/usr/bin/env bash
+
+# Records or replays the HTTP interactions for Acceptance Tests, one test per process:
+#   record: `scripts/testacc-recordings.sh record TestAccAzureRMResourceGroup_basic`
+#   replay: `scripts/testacc-recordings.sh replay` (replays every recording in the directory below)
+RECORDINGS_DIR="$(pwd)/azurerm/testdata/recordings"
+
+mode=$1
+shift
+
+case "${mode}" in
+  record)
+    tests="$@"
+    ;;
+  replay)
+    tests="$@"
+    if [ -z "${tests}" ]; then
+      tests=$(find "${RECORDINGS_DIR}" -name '*.json' -exec basename {} .json \; 2>/dev/null | sort)
+    fi
+
+    # nothing is sent to Azure when replaying, so placeholder credentials are sufficient
+    export ARM_CLIENT_ID=${ARM_CLIENT_ID:-00000000-0000-0000-0000-000000000000}
+    export ARM_CLIENT_SECRET=${ARM_CLIENT_SECRET:-replay}
+    export ARM_SUBSCRIPTION_ID=${ARM_SUBSCRIPTION_ID:-00000000-0000-0000-0000-000000000000}
+    export ARM_TENANT_ID=${ARM_TENANT_ID:-00000000-0000-0000-0000-000000000000}
+    export ARM_TEST_LOCATION=${ARM_TEST_LOCATION:-westeurope}
+    export ARM_TEST_LOCATION_ALT=${ARM_TEST_LOCATION_ALT:-northeurope}
+    ;;
+  *)
+    echo "Usage: $0 record|replay [TestName...]"
+    exit 1
+    ;;
+esac
+
+if [ -z "${tests}" ]; then
+  echo "==> No tests to ${mode}"
+  exit 0
+fi
+
+failed=0
+for test in ${tests}; do
+  echo "==> Running ${test} (${mode})"
+  TF_ACC=1 ARM_TEST_RECORDING_MODE=${mode} ARM_TEST_CASSETTE="${RECORDINGS_DIR}/${test}.json" \
+    go test ./azurerm -v -run "^${test}\$" -timeout 180m || failed=1
+done
+
+exit ${failed}